@@ -5,8 +5,9 @@ package check
 
 import (
 	"fmt"
-	"sort"
+	"slices"
 
+	"github.com/YakDriver/tfproviderdocs/check/diagnostic"
 	"github.com/YakDriver/tfproviderdocs/markdown"
 	"github.com/hashicorp/go-multierror"
 )
@@ -284,9 +285,16 @@ func (check *Check) Run(directories map[string][]string) error {
 		}
 	}
 
-	if result != nil {
-		sort.Sort(result)
+	// Findings are ordered by position rather than by their text, so line 9
+	// of a file is reported before line 10.
+	diagnostics := diagnostic.FromError(result.ErrorOrNil())
+	slices.SortStableFunc(diagnostics, diagnostic.Compare)
+
+	var sorted *multierror.Error
+
+	for _, d := range diagnostics {
+		sorted = multierror.Append(sorted, d)
 	}
 
-	return result.ErrorOrNil()
+	return sorted.ErrorOrNil()
 }
@@ -5,9 +5,9 @@ package check
 
 import (
 	"fmt"
-	"slices"
 
 	"github.com/YakDriver/tfproviderdocs/check/contents"
+	tfjson "github.com/hashicorp/terraform-json"
 )
 
 type ContentsCheck struct {
@@ -41,6 +41,15 @@ type ContentsOptions struct {
 	DisallowImportSection              bool
 	ImportSectionDisallowedMessage     string
 	ArgumentsBylineTexts               []string
+
+	// Schemas contains provider schema blocks keyed by resource name, which
+	// enables schema validation of documentation contents.
+	Schemas map[string]*tfjson.SchemaBlock
+
+	// SchemaBlockType is the Terraform configuration block type of the
+	// documented kind (e.g. resource or data), which enables validation of
+	// example configuration blocks against Schemas.
+	SchemaBlockType string
 }
 
 func NewContentsCheck(opts *ContentsOptions) *ContentsCheck {
@@ -59,7 +68,9 @@ func NewContentsCheck(opts *ContentsOptions) *ContentsCheck {
 	return check
 }
 
-func (check *ContentsCheck) Run(path string, exampleLanguage string, subcategory *string) error {
+// Run checks the contents of the documentation file, which is parsed into
+// file.Contents unless already parsed.
+func (check *ContentsCheck) Run(file *File, exampleLanguage string) error {
 	if !check.Options.Enable {
 		return nil
 	}
@@ -76,7 +87,9 @@ func (check *ContentsCheck) Run(path string, exampleLanguage string, subcategory
 			RequireSection:        check.Options.RequireAttributesSection,
 		},
 		ExamplesSection: &contents.CheckExamplesSectionOptions{
+			BlockType:                 check.Options.SchemaBlockType,
 			ExpectedCodeBlockLanguage: exampleLanguage,
+			Schemas:                   check.Options.Schemas,
 		},
 		TimeoutsSection: &contents.CheckTimeoutsSectionOptions{
 			RequireSection: check.Options.RequireTimeoutsSection,
@@ -114,21 +127,37 @@ func (check *ContentsCheck) Run(path string, exampleLanguage string, subcategory
 		checkOpts.ArgumentsSection.RegionAware = false
 	}
 
-	doc := contents.NewDocument(path, check.Options.ProviderName)
+	if file.Contents == nil {
+		doc := contents.NewDocument(file.FullPath, check.Options.ProviderName)
+
+		if err := doc.ParseSource(file.Source); err != nil {
+			return fmt.Errorf("error parsing file: %w", err)
+		}
+
+		file.Contents = doc
+	}
+
+	doc := file.Contents
+
+	var subcategory *string
 
-	if err := doc.Parse(); err != nil {
-		return fmt.Errorf("error parsing file: %w", err)
+	if file.FrontMatter != nil {
+		subcategory = file.FrontMatter.Subcategory
 	}
 
-	if len(check.Options.IgnoreContentsCheck) > 0 && slices.Contains(check.Options.IgnoreContentsCheck, doc.ResourceName) {
+	if len(check.Options.IgnoreContentsCheck) > 0 && MatchAnyPattern(check.Options.IgnoreContentsCheck, doc.ResourceName) {
 		return nil
 	}
 
-	if len(check.Options.IgnoreEnhancedRegionCheck) > 0 && slices.Contains(check.Options.IgnoreEnhancedRegionCheck, doc.ResourceName) {
+	if block, ok := check.Options.Schemas[doc.ResourceName]; ok {
+		checkOpts.Schema = block
+	}
+
+	if len(check.Options.IgnoreEnhancedRegionCheck) > 0 && MatchAnyPattern(check.Options.IgnoreEnhancedRegionCheck, doc.ResourceName) {
 		checkOpts.ArgumentsSection.RegionAware = false
 	}
 
-	if len(check.Options.IgnoreEnhancedRegionCheckSubcategories) > 0 && subcategory != nil && slices.Contains(check.Options.IgnoreEnhancedRegionCheckSubcategories, *subcategory) {
+	if len(check.Options.IgnoreEnhancedRegionCheckSubcategories) > 0 && subcategory != nil && MatchAnyPattern(check.Options.IgnoreEnhancedRegionCheckSubcategories, *subcategory) {
 		checkOpts.ArgumentsSection.RegionAware = false
 	}
 
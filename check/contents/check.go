@@ -3,7 +3,10 @@
 
 package contents
 
-import "errors"
+import (
+	"github.com/hashicorp/go-multierror"
+	tfjson "github.com/hashicorp/terraform-json"
+)
 
 type CheckOptions struct {
 	ArgumentsSection  *CheckArgumentsSectionOptions
@@ -18,68 +21,76 @@ type CheckOptions struct {
 	AttributesSectionDisallowedMessage string
 	DisallowImportSection              bool
 	ImportSectionDisallowedMessage     string
+
+	// Schema is the provider schema block of the documented resource, which
+	// enables schema validation of the documented arguments when present.
+	Schema *tfjson.SchemaBlock
 }
 
 type CheckTitleSectionOptions struct {
 	AllowedPrefixes []string
 }
 
+// Check verifies the document contents, returning all findings of every
+// section check which are not suppressed by comment directives.
 func (d *Document) Check(opts *CheckOptions) error {
 	d.CheckOptions = opts
 
+	var result *multierror.Error
+
 	if err := d.checkTitleSection(); err != nil {
-		return err
+		result = multierror.Append(result, err)
 	}
 
 	if err := d.checkExampleSection(); err != nil {
-		return err
+		result = multierror.Append(result, err)
 	}
 
 	if d.CheckOptions != nil && d.CheckOptions.SignatureSection != nil {
 		if err := d.checkSignatureSection(); err != nil {
-			return err
+			result = multierror.Append(result, err)
 		}
 	}
 
 	if err := d.checkArgumentsSection(); err != nil {
-		return err
+		result = multierror.Append(result, err)
 	}
 
 	if d.CheckOptions != nil && d.CheckOptions.DisallowAttributesSection {
 		if d.Sections.Attributes != nil {
 			msg := "attribute section is not allowed"
-
 			if d.CheckOptions.AttributesSectionDisallowedMessage != "" {
 				msg = d.CheckOptions.AttributesSectionDisallowedMessage
 			}
-
-			return errors.New(msg)
+			result = multierror.Append(result, d.diagnostic(d.Sections.Attributes.Heading, RuleAttributesSection, "%s", msg))
 		}
 	} else {
 		if err := d.checkAttributesSection(); err != nil {
-			return err
+			result = multierror.Append(result, err)
 		}
 	}
 
 	if err := d.checkTimeoutsSection(); err != nil {
-		return err
+		result = multierror.Append(result, err)
 	}
 
 	if d.CheckOptions != nil && d.CheckOptions.DisallowImportSection {
 		if d.Sections.Import != nil {
 			msg := "import section is not allowed"
-
 			if d.CheckOptions.ImportSectionDisallowedMessage != "" {
 				msg = d.CheckOptions.ImportSectionDisallowedMessage
 			}
-
-			return errors.New(msg)
+			result = multierror.Append(result, d.diagnostic(d.Sections.Import.Heading, RuleImportSection, "%s", msg))
 		}
 	} else {
 		if err := d.checkImportSection(); err != nil {
-			return err
+			result = multierror.Append(result, err)
 		}
 	}
 
-	return nil
+	if err := d.checkSuppressions(); err != nil {
+		result = multierror.Append(result, err)
+	}
+
+	return d.suppress(result.ErrorOrNil())
 }
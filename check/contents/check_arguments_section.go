@@ -5,9 +5,13 @@ package contents
 
 import (
 	"fmt"
+	"maps"
 	"slices"
-	"sort"
 	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/yuin/goldmark/ast"
 )
 
 type CheckArgumentsSectionOptions struct {
@@ -29,13 +33,15 @@ func (d *Document) checkArgumentsSection() error {
 	section := d.Sections.Arguments
 
 	if section == nil {
-		return fmt.Errorf("missing arguments section: ## Argument Reference")
+		return d.diagnostic(nil, RuleArgumentsSection, "missing arguments section: ## Argument Reference")
 	}
 
+	var result *multierror.Error
+
 	heading := section.Heading
 
 	if heading.Level != 2 {
-		return fmt.Errorf("arguments section heading level (%d) should be: 2", heading.Level)
+		result = multierror.Append(result, d.diagnostic(heading, RuleArgumentsSection, "arguments section heading level (%d) should be: 2", heading.Level))
 	}
 
 	headingText := string(heading.Text(d.source))
@@ -45,6 +51,10 @@ func (d *Document) checkArgumentsSection() error {
 		allowedHeadingTexts = checkOpts.AllowedHeadingTexts
 	}
 
+	if d.Layout == LayoutPluginDocs {
+		allowedHeadingTexts = []string{"Schema"}
+	}
+
 	foundHeading := slices.Contains(allowedHeadingTexts, headingText)
 
 	if !foundHeading {
@@ -52,7 +62,7 @@ func (d *Document) checkArgumentsSection() error {
 		for i, v := range allowedHeadingTexts {
 			formatted[i] = fmt.Sprintf("%q", v)
 		}
-		return fmt.Errorf("arguments section heading (%s) should be one of: %s", headingText, strings.Join(formatted, ", "))
+		result = multierror.Append(result, d.diagnostic(heading, RuleArgumentsSection, "arguments section heading (%s) should be one of: %s", headingText, strings.Join(formatted, ", ")))
 	}
 
 	paragraphs := section.Paragraphs
@@ -81,10 +91,16 @@ func (d *Document) checkArgumentsSection() error {
 	}
 	allowedTextsMessage := strings.Join(allowedTexts, ", ")
 
+	// terraform-plugin-docs introduces arguments with Required and Optional
+	// headings rather than bylines.
+	if d.Layout == LayoutPluginDocs {
+		paragraphs = nil
+	}
+
 	switch len(paragraphs) {
 	case 0:
-		if !checkOpts.AllowMissingByline {
-			return fmt.Errorf("argument section byline should be one of: %s", allowedTextsMessage)
+		if !checkOpts.AllowMissingByline && d.Layout != LayoutPluginDocs {
+			result = multierror.Append(result, d.diagnostic(heading, RuleArgumentsByline, "argument section byline should be one of: %s", allowedTextsMessage))
 		}
 	default:
 		if len(expectedBylineTexts) == 0 {
@@ -96,16 +112,16 @@ func (d *Document) checkArgumentsSection() error {
 		found := slices.Contains(expectedBylineTexts, paragraphText)
 
 		if !found {
-			return fmt.Errorf("argument section byline (%s) should be one of: %s", paragraphText, allowedTextsMessage)
+			result = multierror.Append(result, d.diagnostic(paragraphs[0], RuleArgumentsByline, "argument section byline (%s) should be one of: %s", paragraphText, allowedTextsMessage))
 		}
 
 		if paragraphText == "The following arguments are required:" {
 			// Check for Optionals.
 			if n := len(section.SchemaAttributeLists); n > 0 {
-				if slices.ContainsFunc(section.SchemaAttributeLists[0].Items, func(item *SchemaAttributeListItem) bool {
+				if i := slices.IndexFunc(section.SchemaAttributeLists[0].Items, func(item *SchemaAttributeListItem) bool {
 					return item.Optional
-				}) {
-					return fmt.Errorf("required arguments section contains an Optional argument")
+				}); i >= 0 {
+					result = multierror.Append(result, d.diagnostic(section.SchemaAttributeLists[0].Items[i].ListItem, RuleArgumentsByline, "required arguments section contains an Optional argument"))
 				}
 			}
 
@@ -121,15 +137,15 @@ func (d *Document) checkArgumentsSection() error {
 				}
 
 				if idx < 0 {
-					return fmt.Errorf("argument section byline (%s) should be: %q", paragraphText, want)
+					result = multierror.Append(result, d.diagnostic(paragraphs[1], RuleArgumentsByline, "argument section byline (%s) should be: %q", paragraphText, want))
 				}
 
 				// Check for Required.
-				if n := len(section.SchemaAttributeLists); n > idx {
-					if slices.ContainsFunc(section.SchemaAttributeLists[idx].Items, func(item *SchemaAttributeListItem) bool {
+				if n := len(section.SchemaAttributeLists); idx >= 0 && n > idx {
+					if i := slices.IndexFunc(section.SchemaAttributeLists[idx].Items, func(item *SchemaAttributeListItem) bool {
 						return item.Required
-					}) {
-						return fmt.Errorf("optional arguments section contains a Required argument")
+					}); i >= 0 {
+						result = multierror.Append(result, d.diagnostic(section.SchemaAttributeLists[idx].Items[i].ListItem, RuleArgumentsByline, "optional arguments section contains a Required argument"))
 					}
 				}
 			}
@@ -148,17 +164,272 @@ func (d *Document) checkArgumentsSection() error {
 		}
 
 		if !found {
-			return fmt.Errorf("arguments section does not contain an Optional region argument")
+			result = multierror.Append(result, d.diagnostic(heading, RuleArgumentsRegion, "arguments section does not contain an Optional region argument"))
 		}
 	}
 
 	if checkOpts.RequireSchemaOrdering {
-		for _, list := range section.SchemaAttributeLists {
-			if !sort.IsSorted(SchemaAttributeListItemByName(list.Items)) {
-				return fmt.Errorf("arguments section is not sorted by name")
+		if list := unsortedSchemaAttributeList(section.SchemaAttributeLists); list != nil {
+			diag := d.diagnostic(list.List, RuleArgumentsOrdering, "arguments section is not sorted by name")
+			diag.Suggestion = "sort the list items alphabetically by argument name"
+			result = multierror.Append(result, diag)
+		}
+	}
+
+	var schema *tfjson.SchemaBlock
+
+	if d.CheckOptions != nil {
+		schema = d.CheckOptions.Schema
+	}
+
+	// documentedBlocks contains the paths (e.g. rule.filter) of nested
+	// blocks documented by an inline nested list or a sub-section.
+	documentedBlocks := make(map[string]bool)
+
+	if schema != nil {
+		// The required and optional arguments bylines annotate the lists
+		// following them.
+		bylineAnnotations := make(map[*SchemaAttributeList]string)
+
+		if len(paragraphs) > 0 && string(paragraphs[0].Text(d.source)) == "The following arguments are required:" && len(section.SchemaAttributeLists) > 0 {
+			bylineAnnotations[section.SchemaAttributeLists[0]] = "Required"
+
+			for i := 1; i < len(paragraphs) && i < len(section.SchemaAttributeLists); i++ {
+				if string(paragraphs[i].Text(d.source)) == "The following arguments are optional:" {
+					bylineAnnotations[section.SchemaAttributeLists[i]] = "Optional"
+					break
+				}
+			}
+		}
+
+		if err := d.checkArgumentsSchema(heading, section.SchemaAttributeLists, bylineAnnotations, schema, "", documentedBlocks); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+
+	if err := d.checkArgumentsSubsections(section.Children, checkOpts, schema, "", documentedBlocks); err != nil {
+		result = multierror.Append(result, err)
+	}
+
+	if schema != nil {
+		if err := d.checkNestedBlocksDocumented(heading, schema, "", documentedBlocks); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+// checkArgumentsSubsections verifies the byline, ordering, and schema rules
+// of nested block sections below the arguments section, recursively. The
+// block is the schema block of the parent section, if known, and the path
+// prefixes nested block names (e.g. rule.). Sections not named after a nested
+// block of the parent, such as sections of deeper nested blocks at the same
+// heading level, are verified against the first nested block of the name in
+// the schema. Paths of documented nested blocks are added to documentedBlocks.
+func (d *Document) checkArgumentsSubsections(sections []*SchemaAttributeSection, checkOpts *CheckArgumentsSectionOptions, block *tfjson.SchemaBlock, path string, documentedBlocks map[string]bool) error {
+	var result *multierror.Error
+
+	for _, section := range sections {
+		name := path + section.Name
+		nestedBlock := nestedSchemaBlock(block, section.Name)
+
+		if nestedBlock == nil && d.CheckOptions != nil {
+			foundBlocks := findNestedSchemaBlocks(d.CheckOptions.Schema, section.Name)
+
+			if len(foundBlocks) > 1 {
+				result = multierror.Append(result, d.diagnostic(section.Heading, RuleArgumentsSchema, "arguments subsection (%s) matches multiple schema nested blocks: %s", section.Name, strings.Join(slices.Sorted(maps.Keys(foundBlocks)), ", ")))
+			} else {
+				for foundName, foundBlock := range foundBlocks {
+					nestedBlock, name = foundBlock, foundName
+				}
+			}
+		}
+
+		if err := d.checkSubsectionByline(section, RuleArgumentsByline, "arguments", name); err != nil {
+			result = multierror.Append(result, err)
+		}
+
+		if checkOpts.RequireSchemaOrdering {
+			if list := unsortedSchemaAttributeList(section.SchemaAttributeLists); list != nil {
+				diag := d.diagnostic(list.List, RuleArgumentsOrdering, "arguments subsection (%s) is not sorted by name", name)
+				diag.Suggestion = "sort the list items alphabetically by argument name"
+				result = multierror.Append(result, diag)
+			}
+		}
+
+		if nestedBlock != nil && len(section.SchemaAttributeLists) > 0 {
+			documentedBlocks[name] = true
+
+			if err := d.checkArgumentsSchema(section.Heading, section.SchemaAttributeLists, nil, nestedBlock, name+".", documentedBlocks); err != nil {
+				result = multierror.Append(result, err)
+			}
+		}
+
+		if err := d.checkArgumentsSubsections(section.Children, checkOpts, nestedBlock, name+".", documentedBlocks); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+// checkNestedBlocksDocumented verifies that every nested block of the schema
+// block with configurable arguments is documented by an inline nested list or
+// a sub-section, recursively. Undocumented nested blocks are reported at the
+// heading, without their own nested blocks.
+func (d *Document) checkNestedBlocksDocumented(heading ast.Node, block *tfjson.SchemaBlock, path string, documentedBlocks map[string]bool) error {
+	var result *multierror.Error
+
+	for _, name := range slices.Sorted(maps.Keys(block.NestedBlocks)) {
+		nestedBlock := block.NestedBlocks[name].Block
+
+		if nestedBlock == nil || !configurableSchemaBlock(nestedBlock) {
+			continue
+		}
+
+		// The timeouts block is documented by the timeouts section.
+		if path == "" && name == "timeouts" {
+			continue
+		}
+
+		if !documentedBlocks[path+name] {
+			result = multierror.Append(result, d.diagnostic(heading, RuleArgumentsSchema, "arguments section missing nested block documentation: %s", path+name))
+			continue
+		}
+
+		if err := d.checkNestedBlocksDocumented(heading, nestedBlock, path+name+".", documentedBlocks); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+// checkArgumentsSchema verifies that the documented arguments and their
+// Required/Optional annotations match the configurable attributes of the
+// schema block. The bylineAnnotations, if any, are the Required or Optional
+// annotations of the lists documented under the required and optional
+// arguments bylines. Missing arguments are reported at the heading.
+// The path prefixes the names of nested block arguments (e.g. rule.). Inline
+// nested lists of items are verified against the nested block, and their
+// paths are added to documentedBlocks.
+func (d *Document) checkArgumentsSchema(heading ast.Node, lists []*SchemaAttributeList, bylineAnnotations map[*SchemaAttributeList]string, block *tfjson.SchemaBlock, path string, documentedBlocks map[string]bool) error {
+	var result *multierror.Error
+
+	documented := make(map[string]bool)
+
+	for _, list := range lists {
+		for _, item := range list.Items {
+			documented[item.Name] = true
+
+			if err := d.checkSchemaType(item, block, RuleArgumentsSchema, "arguments section argument", path); err != nil {
+				result = multierror.Append(result, err)
+			}
+
+			if err := d.checkSchemaTraits(item, block, RuleArgumentsSchema, "arguments section argument", path); err != nil {
+				result = multierror.Append(result, err)
+			}
+
+			if err := d.checkSchemaDeprecation(item, block, "arguments section argument", path); err != nil {
+				result = multierror.Append(result, err)
+			}
+
+			if nestedBlock := nestedSchemaBlock(block, item.Name); nestedBlock != nil && len(item.Lists) > 0 {
+				documentedBlocks[path+item.Name] = true
+
+				if err := d.checkArgumentsSchema(item.ListItem, item.Lists, nil, nestedBlock, path+item.Name+".", documentedBlocks); err != nil {
+					result = multierror.Append(result, err)
+				}
+			}
+
+			if attribute, ok := block.Attributes[item.Name]; ok {
+				if err := d.checkArgumentAnnotation(item, attribute, bylineAnnotations[list], path); err != nil {
+					result = multierror.Append(result, err)
+				}
+
+				continue
 			}
+
+			if blockType, ok := block.NestedBlocks[item.Name]; ok {
+				if err := d.checkBlockAnnotation(item, blockType, bylineAnnotations[list], path); err != nil {
+					result = multierror.Append(result, err)
+				}
+
+				continue
+			}
+
+			result = multierror.Append(result, d.diagnostic(item.ListItem, RuleArgumentsSchema, "arguments section contains argument (%s) not found in schema", path+item.Name))
 		}
 	}
 
+	for _, name := range slices.Sorted(maps.Keys(block.Attributes)) {
+		attribute := block.Attributes[name]
+
+		if !attribute.Required && !attribute.Optional {
+			continue
+		}
+
+		// Terraform Plugin SDK schemas mark id as Optional, but it cannot be configured.
+		if name == "id" {
+			continue
+		}
+
+		if documented[name] {
+			continue
+		}
+
+		result = multierror.Append(result, d.diagnostic(heading, RuleArgumentsSchema, "arguments section missing schema argument: %s", path+name))
+	}
+
+	return result.ErrorOrNil()
+}
+
+// checkArgumentAnnotation verifies that the Required/Optional annotation of a
+// documented argument agrees with the schema attribute. The bylineAnnotation,
+// if any, is the annotation of the list by its byline, which stands in for a
+// missing annotation of the item.
+func (d *Document) checkArgumentAnnotation(item *SchemaAttributeListItem, attribute *tfjson.SchemaAttribute, bylineAnnotation string, path string) error {
+	if !attribute.Required && !attribute.Optional {
+		return d.diagnostic(item.ListItem, RuleArgumentsAnnotation, "arguments section contains argument (%s) that is computed-only in schema, it should be documented as an attribute", path+item.Name)
+	}
+
+	return d.checkAnnotation(item, "argument", attribute.Required, "", bylineAnnotation, path)
+}
+
+// checkBlockAnnotation verifies that the Required/Optional annotation of a
+// documented nested block agrees with the minimum number of blocks of the
+// schema, which makes the block Required when greater than zero.
+func (d *Document) checkBlockAnnotation(item *SchemaAttributeListItem, blockType *tfjson.SchemaBlockType, bylineAnnotation string, path string) error {
+	return d.checkAnnotation(item, "block", blockType.MinItems > 0, fmt.Sprintf(" (minimum items: %d)", blockType.MinItems), bylineAnnotation, path)
+}
+
+// checkAnnotation verifies that the item has a Required/Optional annotation,
+// or a bylineAnnotation, agreeing with whether the schema requires it. The
+// noun (e.g. block) and reason (e.g. the minimum items of a block) are used
+// in finding messages.
+func (d *Document) checkAnnotation(item *SchemaAttributeListItem, noun string, required bool, reason string, bylineAnnotation string, path string) error {
+	annotation := "Optional"
+
+	if required {
+		annotation = "Required"
+	}
+
+	if !item.Required && !item.Optional && bylineAnnotation == "" {
+		return d.diagnostic(item.ListItem, RuleArgumentsAnnotation, "arguments section %s (%s) is missing annotation, should be: %s", noun, path+item.Name, annotation)
+	}
+
+	if required && item.Optional {
+		return d.diagnostic(item.ListItem, RuleArgumentsAnnotation, "arguments section %s (%s) is annotated Optional, but is Required in schema%s", noun, path+item.Name, reason)
+	}
+
+	if !required && item.Required {
+		return d.diagnostic(item.ListItem, RuleArgumentsAnnotation, "arguments section %s (%s) is annotated Required, but is Optional in schema%s", noun, path+item.Name, reason)
+	}
+
+	if bylineAnnotation != "" && bylineAnnotation != annotation {
+		return d.diagnostic(item.ListItem, RuleArgumentsAnnotation, "%s arguments section contains %s (%s) that is %s in schema", strings.ToLower(bylineAnnotation), noun, path+item.Name, annotation)
+	}
+
 	return nil
 }
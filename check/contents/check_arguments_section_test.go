@@ -4,7 +4,12 @@
 package contents
 
 import (
+	"fmt"
+	"slices"
 	"testing"
+
+	"github.com/YakDriver/tfproviderdocs/check/diagnostic"
+	tfjson "github.com/hashicorp/terraform-json"
 )
 
 func TestCheckArgumentsSection(t *testing.T) {
@@ -69,6 +74,522 @@ func TestCheckArgumentsSection(t *testing.T) {
 			},
 			ExpectError: true,
 		},
+		{
+			Name:         "passing schema",
+			Path:         "testdata/arguments/passing.md",
+			ProviderName: "test",
+			CheckOptions: &CheckOptions{
+				Schema: &tfjson.SchemaBlock{
+					Attributes: map[string]*tfjson.SchemaAttribute{
+						"aaa": {Required: true},
+						"bbb": {Optional: true},
+						"id":  {Computed: true},
+					},
+					NestedBlocks: map[string]*tfjson.SchemaBlockType{
+						"ccc": {},
+					},
+				},
+			},
+		},
+		{
+			Name:         "schema missing argument",
+			Path:         "testdata/arguments/passing.md",
+			ProviderName: "test",
+			CheckOptions: &CheckOptions{
+				Schema: &tfjson.SchemaBlock{
+					Attributes: map[string]*tfjson.SchemaAttribute{
+						"aaa": {Required: true},
+						"bbb": {Optional: true},
+						"ccc": {Optional: true},
+						"ddd": {Optional: true},
+					},
+				},
+			},
+			ExpectError: true,
+		},
+		{
+			Name:         "schema extraneous argument",
+			Path:         "testdata/arguments/passing.md",
+			ProviderName: "test",
+			CheckOptions: &CheckOptions{
+				Schema: &tfjson.SchemaBlock{
+					Attributes: map[string]*tfjson.SchemaAttribute{
+						"aaa": {Required: true},
+						"bbb": {Optional: true},
+					},
+				},
+			},
+			ExpectError: true,
+		},
+		{
+			Name:         "schema required annotated optional",
+			Path:         "testdata/arguments/passing.md",
+			ProviderName: "test",
+			CheckOptions: &CheckOptions{
+				Schema: &tfjson.SchemaBlock{
+					Attributes: map[string]*tfjson.SchemaAttribute{
+						"aaa": {Required: true},
+						"bbb": {Required: true},
+						"ccc": {Optional: true},
+					},
+				},
+			},
+			ExpectError: true,
+		},
+		{
+			Name:         "schema optional annotated required",
+			Path:         "testdata/arguments/passing.md",
+			ProviderName: "test",
+			CheckOptions: &CheckOptions{
+				Schema: &tfjson.SchemaBlock{
+					Attributes: map[string]*tfjson.SchemaAttribute{
+						"aaa": {Optional: true},
+						"bbb": {Optional: true},
+						"ccc": {Optional: true},
+					},
+				},
+			},
+			ExpectError: true,
+		},
+		{
+			Name:         "schema required missing annotation",
+			Path:         "testdata/arguments/missing_annotation.md",
+			ProviderName: "test",
+			CheckOptions: &CheckOptions{
+				Schema: &tfjson.SchemaBlock{
+					Attributes: map[string]*tfjson.SchemaAttribute{
+						"aaa": {Required: true},
+						"bbb": {Optional: true},
+						"ccc": {Optional: true},
+					},
+				},
+			},
+			ExpectError: true,
+		},
+		{
+			Name:         "schema computed-only argument",
+			Path:         "testdata/arguments/passing.md",
+			ProviderName: "test",
+			CheckOptions: &CheckOptions{
+				Schema: &tfjson.SchemaBlock{
+					Attributes: map[string]*tfjson.SchemaAttribute{
+						"aaa": {Required: true},
+						"bbb": {Optional: true},
+						"ccc": {Computed: true},
+					},
+				},
+			},
+			ExpectError: true,
+		},
+		{
+			Name:         "passing schema required byline",
+			Path:         "testdata/arguments/passing_required_byline.md",
+			ProviderName: "test",
+			CheckOptions: &CheckOptions{
+				Schema: &tfjson.SchemaBlock{
+					Attributes: map[string]*tfjson.SchemaAttribute{
+						"aaa": {Required: true},
+						"bbb": {Optional: true, Computed: true},
+					},
+				},
+			},
+		},
+		{
+			Name:         "schema required byline optional argument",
+			Path:         "testdata/arguments/passing_required_byline.md",
+			ProviderName: "test",
+			CheckOptions: &CheckOptions{
+				Schema: &tfjson.SchemaBlock{
+					Attributes: map[string]*tfjson.SchemaAttribute{
+						"aaa": {Optional: true},
+						"bbb": {Optional: true},
+					},
+				},
+			},
+			ExpectError: true,
+		},
+		{
+			Name:         "passing nested",
+			Path:         "testdata/arguments/passing_nested.md",
+			ProviderName: "test",
+			CheckOptions: &CheckOptions{
+				ArgumentsSection: &CheckArgumentsSectionOptions{
+					RequireSchemaOrdering: true,
+				},
+			},
+		},
+		{
+			Name:         "wrong nested byline",
+			Path:         "testdata/arguments/wrong_nested_byline.md",
+			ProviderName: "test",
+			ExpectError:  true,
+		},
+		{
+			Name:         "wrong nested list order",
+			Path:         "testdata/arguments/wrong_nested_list_order.md",
+			ProviderName: "test",
+		},
+		{
+			Name:         "wrong nested list order required",
+			Path:         "testdata/arguments/wrong_nested_list_order.md",
+			ProviderName: "test",
+			CheckOptions: &CheckOptions{
+				ArgumentsSection: &CheckArgumentsSectionOptions{
+					RequireSchemaOrdering: true,
+				},
+			},
+			ExpectError: true,
+		},
+		{
+			Name:         "passing nested schema",
+			Path:         "testdata/arguments/passing_nested.md",
+			ProviderName: "test",
+			CheckOptions: &CheckOptions{
+				Schema: &tfjson.SchemaBlock{
+					Attributes: map[string]*tfjson.SchemaAttribute{
+						"aaa": {Required: true},
+					},
+					NestedBlocks: map[string]*tfjson.SchemaBlockType{
+						"rule": {
+							Block: &tfjson.SchemaBlock{
+								Attributes: map[string]*tfjson.SchemaAttribute{
+									"action": {Required: true},
+								},
+								NestedBlocks: map[string]*tfjson.SchemaBlockType{
+									"filter": {
+										Block: &tfjson.SchemaBlock{
+											Attributes: map[string]*tfjson.SchemaAttribute{
+												"prefix": {Optional: true},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			Name:         "nested schema missing argument",
+			Path:         "testdata/arguments/passing_nested.md",
+			ProviderName: "test",
+			CheckOptions: &CheckOptions{
+				Schema: &tfjson.SchemaBlock{
+					Attributes: map[string]*tfjson.SchemaAttribute{
+						"aaa": {Required: true},
+					},
+					NestedBlocks: map[string]*tfjson.SchemaBlockType{
+						"rule": {
+							Block: &tfjson.SchemaBlock{
+								Attributes: map[string]*tfjson.SchemaAttribute{
+									"action":   {Required: true},
+									"priority": {Optional: true},
+								},
+								NestedBlocks: map[string]*tfjson.SchemaBlockType{
+									"filter": {
+										Block: &tfjson.SchemaBlock{
+											Attributes: map[string]*tfjson.SchemaAttribute{
+												"prefix": {Optional: true},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			ExpectError: true,
+		},
+		{
+			Name:         "passing nested inline schema",
+			Path:         "testdata/arguments/passing_nested_inline.md",
+			ProviderName: "test",
+			CheckOptions: &CheckOptions{
+				Schema: &tfjson.SchemaBlock{
+					Attributes: map[string]*tfjson.SchemaAttribute{
+						"aaa": {Required: true},
+					},
+					NestedBlocks: map[string]*tfjson.SchemaBlockType{
+						"rule": {
+							Block: &tfjson.SchemaBlock{
+								Attributes: map[string]*tfjson.SchemaAttribute{
+									"action": {Required: true},
+								},
+								NestedBlocks: map[string]*tfjson.SchemaBlockType{
+									"filter": {
+										Block: &tfjson.SchemaBlock{
+											Attributes: map[string]*tfjson.SchemaAttribute{
+												"prefix": {Optional: true},
+											},
+										},
+									},
+								},
+							},
+						},
+						"timeouts": {
+							Block: &tfjson.SchemaBlock{
+								Attributes: map[string]*tfjson.SchemaAttribute{
+									"create": {Optional: true},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			Name:         "passing nested flat schema",
+			Path:         "testdata/arguments/passing_nested_flat.md",
+			ProviderName: "test",
+			CheckOptions: &CheckOptions{
+				Schema: &tfjson.SchemaBlock{
+					Attributes: map[string]*tfjson.SchemaAttribute{
+						"aaa": {Required: true},
+					},
+					NestedBlocks: map[string]*tfjson.SchemaBlockType{
+						"rule": {
+							Block: &tfjson.SchemaBlock{
+								Attributes: map[string]*tfjson.SchemaAttribute{
+									"action": {Required: true},
+								},
+								NestedBlocks: map[string]*tfjson.SchemaBlockType{
+									"filter": {
+										Block: &tfjson.SchemaBlock{
+											Attributes: map[string]*tfjson.SchemaAttribute{
+												"prefix": {Optional: true},
+											},
+										},
+									},
+								},
+							},
+						},
+						"timeouts": {
+							Block: &tfjson.SchemaBlock{
+								Attributes: map[string]*tfjson.SchemaAttribute{
+									"create": {Optional: true},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			Name:         "nested flat schema ambiguous block",
+			Path:         "testdata/arguments/passing_nested_flat.md",
+			ProviderName: "test",
+			CheckOptions: &CheckOptions{
+				Schema: &tfjson.SchemaBlock{
+					Attributes: map[string]*tfjson.SchemaAttribute{
+						"aaa": {Required: true},
+					},
+					NestedBlocks: map[string]*tfjson.SchemaBlockType{
+						"rule": {
+							Block: &tfjson.SchemaBlock{
+								Attributes: map[string]*tfjson.SchemaAttribute{
+									"action": {Required: true},
+								},
+								NestedBlocks: map[string]*tfjson.SchemaBlockType{
+									"filter": {
+										Block: &tfjson.SchemaBlock{
+											Attributes: map[string]*tfjson.SchemaAttribute{
+												"prefix": {Optional: true},
+											},
+										},
+									},
+								},
+							},
+						},
+						"timeouts": {
+							Block: &tfjson.SchemaBlock{
+								Attributes: map[string]*tfjson.SchemaAttribute{
+									"create": {Optional: true},
+								},
+								NestedBlocks: map[string]*tfjson.SchemaBlockType{
+									"filter": {
+										Block: &tfjson.SchemaBlock{
+											Attributes: map[string]*tfjson.SchemaAttribute{
+												"prefix": {Optional: true},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			ExpectError: true,
+		},
+		{
+			Name:         "nested inline schema extraneous argument",
+			Path:         "testdata/arguments/passing_nested_inline.md",
+			ProviderName: "test",
+			CheckOptions: &CheckOptions{
+				Schema: &tfjson.SchemaBlock{
+					Attributes: map[string]*tfjson.SchemaAttribute{
+						"aaa": {Required: true},
+					},
+					NestedBlocks: map[string]*tfjson.SchemaBlockType{
+						"rule": {
+							Block: &tfjson.SchemaBlock{
+								Attributes: map[string]*tfjson.SchemaAttribute{
+									"action": {Required: true},
+								},
+								NestedBlocks: map[string]*tfjson.SchemaBlockType{
+									"filter": {
+										Block: &tfjson.SchemaBlock{
+											Attributes: map[string]*tfjson.SchemaAttribute{
+												"suffix": {Optional: true},
+											},
+										},
+									},
+								},
+							},
+						},
+						"timeouts": {
+							Block: &tfjson.SchemaBlock{
+								Attributes: map[string]*tfjson.SchemaAttribute{
+									"create": {Optional: true},
+								},
+							},
+						},
+					},
+				},
+			},
+			ExpectError: true,
+		},
+		{
+			Name:         "nested schema missing block documentation",
+			Path:         "testdata/arguments/passing_nested.md",
+			ProviderName: "test",
+			CheckOptions: &CheckOptions{
+				Schema: &tfjson.SchemaBlock{
+					Attributes: map[string]*tfjson.SchemaAttribute{
+						"aaa": {Required: true},
+					},
+					NestedBlocks: map[string]*tfjson.SchemaBlockType{
+						"rule": {
+							Block: &tfjson.SchemaBlock{
+								Attributes: map[string]*tfjson.SchemaAttribute{
+									"action": {Required: true},
+								},
+								NestedBlocks: map[string]*tfjson.SchemaBlockType{
+									"filter": {
+										Block: &tfjson.SchemaBlock{
+											Attributes: map[string]*tfjson.SchemaAttribute{
+												"prefix": {Optional: true},
+											},
+										},
+									},
+									"condition": {
+										Block: &tfjson.SchemaBlock{
+											Attributes: map[string]*tfjson.SchemaAttribute{
+												"test": {Optional: true},
+											},
+										},
+									},
+								},
+							},
+						},
+						"timeouts": {
+							Block: &tfjson.SchemaBlock{
+								Attributes: map[string]*tfjson.SchemaAttribute{
+									"create": {Optional: true},
+								},
+							},
+						},
+					},
+				},
+			},
+			ExpectError: true,
+		},
+		{
+			Name:         "nested flat schema missing block documentation",
+			Path:         "testdata/arguments/passing_nested_flat.md",
+			ProviderName: "test",
+			CheckOptions: &CheckOptions{
+				Schema: &tfjson.SchemaBlock{
+					Attributes: map[string]*tfjson.SchemaAttribute{
+						"aaa": {Required: true},
+					},
+					NestedBlocks: map[string]*tfjson.SchemaBlockType{
+						"rule": {
+							Block: &tfjson.SchemaBlock{
+								Attributes: map[string]*tfjson.SchemaAttribute{
+									"action": {Required: true},
+								},
+								NestedBlocks: map[string]*tfjson.SchemaBlockType{
+									"filter": {
+										Block: &tfjson.SchemaBlock{
+											Attributes: map[string]*tfjson.SchemaAttribute{
+												"prefix": {Optional: true},
+											},
+										},
+									},
+									"condition": {
+										Block: &tfjson.SchemaBlock{
+											Attributes: map[string]*tfjson.SchemaAttribute{
+												"test": {Optional: true},
+											},
+										},
+									},
+								},
+							},
+						},
+						"timeouts": {
+							Block: &tfjson.SchemaBlock{
+								Attributes: map[string]*tfjson.SchemaAttribute{
+									"create": {Optional: true},
+								},
+							},
+						},
+					},
+				},
+			},
+			ExpectError: true,
+		},
+		{
+			Name:         "nested schema block annotated optional",
+			Path:         "testdata/arguments/passing_nested.md",
+			ProviderName: "test",
+			CheckOptions: &CheckOptions{
+				Schema: &tfjson.SchemaBlock{
+					Attributes: map[string]*tfjson.SchemaAttribute{
+						"aaa": {Required: true},
+					},
+					NestedBlocks: map[string]*tfjson.SchemaBlockType{
+						"rule": {
+							Block: &tfjson.SchemaBlock{
+								Attributes: map[string]*tfjson.SchemaAttribute{
+									"action": {Required: true},
+								},
+								NestedBlocks: map[string]*tfjson.SchemaBlockType{
+									"filter": {
+										Block: &tfjson.SchemaBlock{
+											Attributes: map[string]*tfjson.SchemaAttribute{
+												"prefix": {Optional: true},
+											},
+										},
+									},
+								},
+							},
+							MinItems: 1,
+						},
+						"timeouts": {
+							Block: &tfjson.SchemaBlock{
+								Attributes: map[string]*tfjson.SchemaAttribute{
+									"create": {Optional: true},
+								},
+							},
+						},
+					},
+				},
+			},
+			ExpectError: true,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -93,3 +614,95 @@ func TestCheckArgumentsSection(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckArgumentsSectionAnnotation(t *testing.T) {
+	ruleBlock := &tfjson.SchemaBlock{
+		Attributes: map[string]*tfjson.SchemaAttribute{
+			"action": {Required: true},
+		},
+	}
+	filterBlock := &tfjson.SchemaBlock{
+		Attributes: map[string]*tfjson.SchemaAttribute{
+			"prefix": {Optional: true},
+		},
+	}
+
+	testCases := []struct {
+		Name   string
+		Path   string
+		Schema *tfjson.SchemaBlock
+		Expect []string
+	}{
+		{
+			Name: "byline annotations",
+			Path: "testdata/arguments/byline_annotations.md",
+			Schema: &tfjson.SchemaBlock{
+				Attributes: map[string]*tfjson.SchemaAttribute{
+					"aaa": {Required: true},
+					"bbb": {Optional: true},
+					"ccc": {Optional: true},
+				},
+				NestedBlocks: map[string]*tfjson.SchemaBlockType{
+					"filter": {Block: filterBlock},
+					"rule":   {Block: ruleBlock, MinItems: 1},
+				},
+			},
+		},
+		{
+			Name: "byline annotations mismatch",
+			Path: "testdata/arguments/byline_annotations.md",
+			Schema: &tfjson.SchemaBlock{
+				Attributes: map[string]*tfjson.SchemaAttribute{
+					"aaa": {Required: true},
+					"bbb": {Optional: true},
+					"ccc": {Required: true},
+				},
+				NestedBlocks: map[string]*tfjson.SchemaBlockType{
+					"filter": {Block: filterBlock},
+					"rule":   {Block: ruleBlock},
+				},
+			},
+			Expect: []string{
+				"9:3: required arguments section contains block (rule) that is Optional in schema",
+				"15:3: optional arguments section contains argument (ccc) that is Required in schema",
+			},
+		},
+		{
+			Name: "missing block annotation",
+			Path: "testdata/arguments/missing_block_annotation.md",
+			Schema: &tfjson.SchemaBlock{
+				Attributes: map[string]*tfjson.SchemaAttribute{
+					"aaa": {Required: true},
+				},
+				NestedBlocks: map[string]*tfjson.SchemaBlockType{
+					"rule": {Block: ruleBlock, MinItems: 1},
+				},
+			},
+			Expect: []string{
+				"9:3: arguments section block (rule) is missing annotation, should be: Required",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			doc := NewDocument(testCase.Path, "test")
+
+			if err := doc.Parse(); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			doc.CheckOptions = &CheckOptions{Schema: testCase.Schema}
+
+			var got []string
+
+			for _, d := range diagnostic.FromError(doc.checkArgumentsSection()) {
+				got = append(got, fmt.Sprintf("%d:%d: %s", d.Line, d.Column, d.Message))
+			}
+
+			if !slices.Equal(got, testCase.Expect) {
+				t.Errorf("expected %q, got %q", testCase.Expect, got)
+			}
+		})
+	}
+}
@@ -4,9 +4,13 @@
 package contents
 
 import (
-	"fmt"
+	"maps"
 	"slices"
-	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/yuin/goldmark/ast"
 )
 
 type SectionRequirement int
@@ -31,22 +35,43 @@ func (d *Document) checkAttributesSection() error {
 
 	section := d.Sections.Attributes
 
+	var schema *tfjson.SchemaBlock
+
+	if d.CheckOptions != nil {
+		schema = d.CheckOptions.Schema
+	}
+
 	if section == nil {
 		if checkOpts.RequireSection == Required {
-			return fmt.Errorf("missing attribute section: ## Attribute Reference")
-		} else {
-			return nil
+			return d.diagnostic(nil, RuleAttributesSection, "missing attribute section: ## Attribute Reference")
 		}
+
+		if checkOpts.RequireSection == Optional && schema != nil {
+			if names := computedOnlyAttributeNames(schema); len(names) > 0 {
+				return d.diagnostic(nil, RuleAttributesSchema, "missing attribute section for computed-only schema attributes: %s", strings.Join(names, ", "))
+			}
+		}
+
+		return nil
 	} else {
 		if checkOpts.RequireSection == Forbidden {
-			return fmt.Errorf("attribute section should not be present")
+			return d.diagnostic(section.Heading, RuleAttributesSection, "attribute section should not be present")
 		}
 	}
 
+	var result *multierror.Error
+
 	heading := section.Heading
+	paragraphs := section.Paragraphs
+
+	// terraform-plugin-docs documents attributes below a Read-Only heading of
+	// the Schema section, without bylines.
+	if d.Layout == LayoutPluginDocs {
+		return d.checkPluginDocsAttributes(section, checkOpts, schema)
+	}
 
 	if heading.Level != 2 {
-		return fmt.Errorf("attribute section heading level (%d) should be: 2", heading.Level)
+		result = multierror.Append(result, d.diagnostic(heading, RuleAttributesSection, "attribute section heading level (%d) should be: 2", heading.Level))
 	}
 
 	headingText := string(heading.Text(d.source))
@@ -55,10 +80,9 @@ func (d *Document) checkAttributesSection() error {
 	}
 
 	if headingText != expectedHeadingTexts[0] {
-		return fmt.Errorf("attribute section heading (%s) should be: %q", headingText, expectedHeadingTexts[0])
+		result = multierror.Append(result, d.diagnostic(heading, RuleAttributesSection, "attribute section heading (%s) should be: %q", headingText, expectedHeadingTexts[0]))
 	}
 
-	paragraphs := section.Paragraphs
 	expectedBylineTexts := []string{
 		"This resource exports the following attributes in addition to the arguments above:",
 		"This ephemeral resource exports the following attributes in addition to the arguments above:",
@@ -70,24 +94,193 @@ func (d *Document) checkAttributesSection() error {
 
 	switch len(paragraphs) {
 	case 0:
-		return fmt.Errorf("attribute section byline should be: %q, %q, %q, or %q", expectedBylineTexts[0], expectedBylineTexts[1], expectedBylineTexts[2], expectedBylineTexts[3])
+		result = multierror.Append(result, d.diagnostic(heading, RuleAttributesByline, "attribute section byline should be: %q, %q, %q, or %q", expectedBylineTexts[0], expectedBylineTexts[1], expectedBylineTexts[2], expectedBylineTexts[3]))
 	case 1:
 		paragraphText := string(paragraphs[0].Text(d.source))
 
 		found := slices.Contains(expectedBylineTexts, paragraphText)
 
 		if !found {
-			return fmt.Errorf("attribute section byline (%s) should be: %q, %q, %q, or %q", paragraphText, expectedBylineTexts[0], expectedBylineTexts[1], expectedBylineTexts[2], expectedBylineTexts[3])
+			result = multierror.Append(result, d.diagnostic(paragraphs[0], RuleAttributesByline, "attribute section byline (%s) should be: %q, %q, %q, or %q", paragraphText, expectedBylineTexts[0], expectedBylineTexts[1], expectedBylineTexts[2], expectedBylineTexts[3]))
+		}
+	}
+
+	if checkOpts.RequireSchemaOrdering {
+		if list := unsortedSchemaAttributeList(section.SchemaAttributeLists); list != nil {
+			diag := d.diagnostic(list.List, RuleAttributesOrdering, "attribute section is not sorted by name")
+			diag.Suggestion = "sort the list items alphabetically by attribute name"
+			result = multierror.Append(result, diag)
+		}
+	}
+
+	if schema != nil {
+		if len(paragraphs) > 0 {
+			paragraphText := string(paragraphs[0].Text(d.source))
+
+			if strings.HasSuffix(paragraphText, "exports no additional attributes.") {
+				if names := computedOnlyAttributeNames(schema); len(names) > 0 {
+					result = multierror.Append(result, d.diagnostic(paragraphs[0], RuleAttributesSchema, "attribute section byline (%s) is incorrect, schema contains computed-only attributes: %s", paragraphText, strings.Join(names, ", ")))
+				}
+			}
+		}
+
+		if err := d.checkAttributesSchema(heading, section.SchemaAttributeLists, schema, ""); err != nil {
+			result = multierror.Append(result, err)
 		}
 	}
 
+	if err := d.checkAttributesSubsections(section.Children, checkOpts, schema, ""); err != nil {
+		result = multierror.Append(result, err)
+	}
+
+	return result.ErrorOrNil()
+}
+
+// checkPluginDocsAttributes verifies the ordering and schema rules of the
+// Read-Only attributes of the terraform-plugin-docs Schema section and its
+// nested sections.
+func (d *Document) checkPluginDocsAttributes(section *AttributesSection, checkOpts *CheckAttributesSectionOptions, schema *tfjson.SchemaBlock) error {
+	var result *multierror.Error
+
 	if checkOpts.RequireSchemaOrdering {
-		for _, list := range section.SchemaAttributeLists {
-			if !sort.IsSorted(SchemaAttributeListItemByName(list.Items)) {
-				return fmt.Errorf("attribute section is not sorted by name")
+		if list := unsortedSchemaAttributeList(section.SchemaAttributeLists); list != nil {
+			diag := d.diagnostic(list.List, RuleAttributesOrdering, "attribute section is not sorted by name")
+			diag.Suggestion = "sort the list items alphabetically by attribute name"
+			result = multierror.Append(result, diag)
+		}
+	}
+
+	if schema != nil {
+		if err := d.checkAttributesSchema(section.Heading, section.SchemaAttributeLists, schema, ""); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+
+	if err := d.checkAttributesSubsections(section.Children, checkOpts, schema, ""); err != nil {
+		result = multierror.Append(result, err)
+	}
+
+	return result.ErrorOrNil()
+}
+
+// checkAttributesSubsections verifies the byline, ordering, and schema rules
+// of nested block sections below the attributes section, recursively. The
+// block is the schema block of the parent section, if known, and the path
+// prefixes nested block names (e.g. rule.). Sections not named after a nested
+// block of the parent are verified against the first nested block of the name
+// in the schema.
+func (d *Document) checkAttributesSubsections(sections []*SchemaAttributeSection, checkOpts *CheckAttributesSectionOptions, block *tfjson.SchemaBlock, path string) error {
+	var result *multierror.Error
+
+	for _, section := range sections {
+		name := path + section.Name
+		nestedBlock := nestedSchemaBlock(block, section.Name)
+
+		if nestedBlock == nil && d.CheckOptions != nil {
+			foundBlocks := findNestedSchemaBlocks(d.CheckOptions.Schema, section.Name)
+
+			if len(foundBlocks) > 1 {
+				result = multierror.Append(result, d.diagnostic(section.Heading, RuleAttributesSchema, "attribute subsection (%s) matches multiple schema nested blocks: %s", section.Name, strings.Join(slices.Sorted(maps.Keys(foundBlocks)), ", ")))
+			} else {
+				for foundName, foundBlock := range foundBlocks {
+					nestedBlock, name = foundBlock, foundName
+				}
 			}
 		}
+
+		if err := d.checkSubsectionByline(section, RuleAttributesByline, "attribute", name); err != nil {
+			result = multierror.Append(result, err)
+		}
+
+		if checkOpts.RequireSchemaOrdering {
+			if list := unsortedSchemaAttributeList(section.SchemaAttributeLists); list != nil {
+				diag := d.diagnostic(list.List, RuleAttributesOrdering, "attribute subsection (%s) is not sorted by name", name)
+				diag.Suggestion = "sort the list items alphabetically by attribute name"
+				result = multierror.Append(result, diag)
+			}
+		}
+
+		if nestedBlock != nil && len(section.SchemaAttributeLists) > 0 {
+			if err := d.checkAttributesSchema(section.Heading, section.SchemaAttributeLists, nestedBlock, name+"."); err != nil {
+				result = multierror.Append(result, err)
+			}
+		}
+
+		if err := d.checkAttributesSubsections(section.Children, checkOpts, nestedBlock, name+"."); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+// checkAttributesSchema verifies that the documented attributes exist in the
+// schema block and that every computed-only schema attribute is documented.
+// Missing attributes are reported at the heading. The path prefixes the names
+// of nested block attributes (e.g. rule.). Inline nested lists of items are
+// verified against the nested block.
+func (d *Document) checkAttributesSchema(heading ast.Node, lists []*SchemaAttributeList, block *tfjson.SchemaBlock, path string) error {
+	var result *multierror.Error
+
+	documented := make(map[string]bool)
+
+	for _, list := range lists {
+		for _, item := range list.Items {
+			documented[item.Name] = true
+
+			if err := d.checkSchemaType(item, block, RuleAttributesSchema, "attribute section attribute", path); err != nil {
+				result = multierror.Append(result, err)
+			}
+
+			if err := d.checkSchemaTraits(item, block, RuleAttributesSchema, "attribute section attribute", path); err != nil {
+				result = multierror.Append(result, err)
+			}
+
+			if err := d.checkSchemaDeprecation(item, block, "attribute section attribute", path); err != nil {
+				result = multierror.Append(result, err)
+			}
+
+			if nestedBlock := nestedSchemaBlock(block, item.Name); nestedBlock != nil && len(item.Lists) > 0 {
+				if err := d.checkAttributesSchema(item.ListItem, item.Lists, nestedBlock, path+item.Name+"."); err != nil {
+					result = multierror.Append(result, err)
+				}
+			}
+
+			if _, ok := block.Attributes[item.Name]; ok {
+				continue
+			}
+
+			if _, ok := block.NestedBlocks[item.Name]; ok {
+				continue
+			}
+
+			result = multierror.Append(result, d.diagnostic(item.ListItem, RuleAttributesSchema, "attribute section contains attribute (%s) not found in schema", path+item.Name))
+		}
+	}
+
+	for _, name := range computedOnlyAttributeNames(block) {
+		if documented[name] {
+			continue
+		}
+
+		result = multierror.Append(result, d.diagnostic(heading, RuleAttributesSchema, "attribute section missing computed-only schema attribute: %s", path+name))
+	}
+
+	return result.ErrorOrNil()
+}
+
+// computedOnlyAttributeNames returns the sorted names of schema attributes
+// which are neither Required nor Optional.
+func computedOnlyAttributeNames(block *tfjson.SchemaBlock) []string {
+	var names []string
+
+	for _, name := range slices.Sorted(maps.Keys(block.Attributes)) {
+		attribute := block.Attributes[name]
+
+		if attribute.Computed && !attribute.Optional && !attribute.Required {
+			names = append(names, name)
+		}
 	}
 
-	return nil
+	return names
 }
@@ -5,6 +5,8 @@ package contents
 
 import (
 	"testing"
+
+	tfjson "github.com/hashicorp/terraform-json"
 )
 
 func TestCheckAttributesSection(t *testing.T) {
@@ -92,6 +94,195 @@ func TestCheckAttributesSection(t *testing.T) {
 			},
 			ExpectError: true,
 		},
+		{
+			Name:         "passing schema",
+			Path:         "testdata/attributes/passing.md",
+			ProviderName: "test",
+			CheckOptions: &CheckOptions{
+				Schema: &tfjson.SchemaBlock{
+					Attributes: map[string]*tfjson.SchemaAttribute{
+						"aaa":  {Computed: true},
+						"bbb":  {Computed: true},
+						"ccc":  {Optional: true, Computed: true},
+						"name": {Required: true},
+					},
+				},
+			},
+		},
+		{
+			Name:         "schema missing computed-only attribute",
+			Path:         "testdata/attributes/passing.md",
+			ProviderName: "test",
+			CheckOptions: &CheckOptions{
+				Schema: &tfjson.SchemaBlock{
+					Attributes: map[string]*tfjson.SchemaAttribute{
+						"aaa": {Computed: true},
+						"bbb": {Computed: true},
+						"ccc": {Computed: true},
+						"ddd": {Computed: true},
+					},
+				},
+			},
+			ExpectError: true,
+		},
+		{
+			Name:         "schema extraneous attribute",
+			Path:         "testdata/attributes/passing.md",
+			ProviderName: "test",
+			CheckOptions: &CheckOptions{
+				Schema: &tfjson.SchemaBlock{
+					Attributes: map[string]*tfjson.SchemaAttribute{
+						"aaa": {Computed: true},
+						"bbb": {Computed: true},
+					},
+				},
+			},
+			ExpectError: true,
+		},
+		{
+			Name:         "passing schema no additional attributes",
+			Path:         "testdata/attributes/passing_no_additional.md",
+			ProviderName: "test",
+			CheckOptions: &CheckOptions{
+				Schema: &tfjson.SchemaBlock{
+					Attributes: map[string]*tfjson.SchemaAttribute{
+						"name": {Required: true},
+					},
+				},
+			},
+		},
+		{
+			Name:         "schema no additional attributes with computed-only attribute",
+			Path:         "testdata/attributes/passing_no_additional.md",
+			ProviderName: "test",
+			CheckOptions: &CheckOptions{
+				Schema: &tfjson.SchemaBlock{
+					Attributes: map[string]*tfjson.SchemaAttribute{
+						"arn":  {Computed: true},
+						"name": {Required: true},
+					},
+				},
+			},
+			ExpectError: true,
+		},
+		{
+			Name:         "schema missing section with computed-only attribute",
+			Path:         "testdata/attributes/missing_heading.md",
+			ProviderName: "test",
+			CheckOptions: &CheckOptions{
+				Schema: &tfjson.SchemaBlock{
+					Attributes: map[string]*tfjson.SchemaAttribute{
+						"arn": {Computed: true},
+					},
+				},
+			},
+			ExpectError: true,
+		},
+		{
+			Name:         "passing nested",
+			Path:         "testdata/attributes/passing_nested.md",
+			ProviderName: "test",
+			CheckOptions: &CheckOptions{
+				AttributesSection: &CheckAttributesSectionOptions{
+					RequireSchemaOrdering: true,
+				},
+				Schema: &tfjson.SchemaBlock{
+					Attributes: map[string]*tfjson.SchemaAttribute{
+						"arn": {Computed: true},
+						"status": {
+							AttributeNestedType: &tfjson.SchemaNestedAttributeType{
+								Attributes: map[string]*tfjson.SchemaAttribute{
+									"code":    {Computed: true},
+									"message": {Computed: true},
+								},
+							},
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+		{
+			Name:         "nested schema missing attribute",
+			Path:         "testdata/attributes/passing_nested.md",
+			ProviderName: "test",
+			CheckOptions: &CheckOptions{
+				Schema: &tfjson.SchemaBlock{
+					Attributes: map[string]*tfjson.SchemaAttribute{
+						"arn": {Computed: true},
+						"status": {
+							AttributeNestedType: &tfjson.SchemaNestedAttributeType{
+								Attributes: map[string]*tfjson.SchemaAttribute{
+									"code":    {Computed: true},
+									"message": {Computed: true},
+									"reason":  {Computed: true},
+								},
+							},
+							Computed: true,
+						},
+					},
+				},
+			},
+			ExpectError: true,
+		},
+		{
+			Name:         "passing nested inline",
+			Path:         "testdata/attributes/passing_nested_inline.md",
+			ProviderName: "test",
+			CheckOptions: &CheckOptions{
+				AttributesSection: &CheckAttributesSectionOptions{
+					RequireSchemaOrdering: true,
+				},
+				Schema: &tfjson.SchemaBlock{
+					Attributes: map[string]*tfjson.SchemaAttribute{
+						"arn": {Computed: true},
+						"status": {
+							AttributeNestedType: &tfjson.SchemaNestedAttributeType{
+								Attributes: map[string]*tfjson.SchemaAttribute{
+									"code":    {Computed: true},
+									"message": {Computed: true},
+								},
+							},
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+		{
+			Name:         "nested inline schema missing attribute",
+			Path:         "testdata/attributes/passing_nested_inline.md",
+			ProviderName: "test",
+			CheckOptions: &CheckOptions{
+				Schema: &tfjson.SchemaBlock{
+					Attributes: map[string]*tfjson.SchemaAttribute{
+						"arn": {Computed: true},
+						"status": {
+							AttributeNestedType: &tfjson.SchemaNestedAttributeType{
+								Attributes: map[string]*tfjson.SchemaAttribute{
+									"code":    {Computed: true},
+									"message": {Computed: true},
+									"reason":  {Computed: true},
+								},
+							},
+							Computed: true,
+						},
+					},
+				},
+			},
+			ExpectError: true,
+		},
+		{
+			Name:         "wrong nested list order",
+			Path:         "testdata/attributes/wrong_nested_list_order.md",
+			ProviderName: "test",
+			CheckOptions: &CheckOptions{
+				AttributesSection: &CheckAttributesSectionOptions{
+					RequireSchemaOrdering: true,
+				},
+			},
+			ExpectError: true,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -0,0 +1,84 @@
+// Copyright IBM Corp. 2019, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package contents
+
+import (
+	"regexp"
+	"strings"
+
+	tfjson "github.com/hashicorp/terraform-json"
+)
+
+// checkTitleDeprecation verifies that the title section of a resource
+// deprecated in the schema has a warning callout mentioning the deprecation,
+// such as: ~> **Warning:** This resource is deprecated.
+func (d *Document) checkTitleDeprecation(section *TitleSection) error {
+	if d.CheckOptions == nil || d.CheckOptions.Schema == nil || !d.CheckOptions.Schema.Deprecated {
+		return nil
+	}
+
+	for _, paragraph := range section.Paragraphs {
+		paragraphText := string(paragraph.Text(d.source))
+
+		if !strings.HasPrefix(paragraphText, "~>") && !strings.HasPrefix(paragraphText, "!>") {
+			continue
+		}
+
+		if strings.Contains(strings.ToLower(paragraphText), "deprecat") {
+			return nil
+		}
+	}
+
+	diag := d.diagnostic(section.Heading, RuleDeprecation, "title section missing warning callout for resource deprecated in schema")
+	diag.Suggestion = "add a callout below the title, e.g.: ~> **Warning:** This resource is deprecated."
+
+	return diag
+}
+
+// checkSchemaDeprecation verifies that the item is documented as deprecated
+// if, and only if, the attribute or nested block of the same name in the
+// schema block is deprecated. Items not found in the schema are left to the
+// caller. The subject (e.g. arguments section argument) prefixes finding
+// messages.
+func (d *Document) checkSchemaDeprecation(item *SchemaAttributeListItem, block *tfjson.SchemaBlock, subject string, path string) error {
+	var deprecated bool
+
+	if attribute, ok := block.Attributes[item.Name]; ok {
+		deprecated = attribute.Deprecated
+	} else if blockType, ok := block.NestedBlocks[item.Name]; ok && blockType.Block != nil {
+		deprecated = blockType.Block.Deprecated
+	} else {
+		return nil
+	}
+
+	documented := documentedDeprecated(item)
+
+	if deprecated && !documented {
+		diag := d.diagnostic(item.ListItem, RuleDeprecation, "%s (%s) is deprecated in schema, but its description has no deprecation notice", subject, path+item.Name)
+		diag.Suggestion = "start the description with: **Deprecated** Use ... instead."
+
+		return diag
+	}
+
+	if !deprecated && documented {
+		return d.diagnostic(item.ListItem, RuleDeprecation, "%s (%s) is documented as deprecated, but is not deprecated in schema", subject, path+item.Name)
+	}
+
+	return nil
+}
+
+// deprecationNoticeRegexp matches a deprecation notice in a description: a
+// **Deprecated** or Deprecated: marker anywhere, or a sentence starting with
+// Deprecated or stating "This argument is deprecated".
+var deprecationNoticeRegexp = regexp.MustCompile(`\*\*Deprecated\*\*|\bDeprecated:|(?:^|[.!?]\s+)[*_]*(?:Deprecated\b|This \w+ is deprecated\b)`)
+
+// documentedDeprecated returns whether the item has the Deprecated trait or a
+// deprecation notice in its description, such as "**Deprecated** Use ...".
+func documentedDeprecated(item *SchemaAttributeListItem) bool {
+	if item.Deprecated {
+		return true
+	}
+
+	return deprecationNoticeRegexp.MatchString(strings.TrimSpace(item.Description))
+}
@@ -0,0 +1,80 @@
+// Copyright IBM Corp. 2019, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package contents
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/YakDriver/tfproviderdocs/check/diagnostic"
+	tfjson "github.com/hashicorp/terraform-json"
+)
+
+func TestCheckDeprecation(t *testing.T) {
+	schema := &tfjson.SchemaBlock{
+		Attributes: map[string]*tfjson.SchemaAttribute{
+			"arn":      {Computed: true},
+			"id":       {Computed: true, Optional: true},
+			"legacy":   {Deprecated: true, Optional: true},
+			"name":     {Required: true},
+			"old_arn":  {Computed: true, Deprecated: true},
+			"old_name": {Deprecated: true, Optional: true},
+			"tags":     {Optional: true},
+			"zone":     {Deprecated: true, Optional: true},
+		},
+		Deprecated: true,
+	}
+
+	testCases := []struct {
+		Name   string
+		Path   string
+		Schema *tfjson.SchemaBlock
+		Expect []string
+	}{
+		{
+			Name:   "passing",
+			Path:   "testdata/deprecation/passing.md",
+			Schema: schema,
+		},
+		{
+			Name:   "missing notices",
+			Path:   "testdata/deprecation/missing_notices.md",
+			Schema: schema,
+			Expect: []string{
+				"11:1: title section missing warning callout for resource deprecated in schema",
+				"27:3: arguments section argument (legacy) is deprecated in schema, but its description has no deprecation notice",
+				"29:3: arguments section argument (old_name) is deprecated in schema, but its description has no deprecation notice",
+				"31:3: arguments section argument (zone) is deprecated in schema, but its description has no deprecation notice",
+				"37:3: attribute section attribute (arn) is documented as deprecated, but is not deprecated in schema",
+				"38:3: attribute section attribute (old_arn) is deprecated in schema, but its description has no deprecation notice",
+			},
+		},
+		{
+			Name: "without schema",
+			Path: "testdata/deprecation/missing_notices.md",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			doc := NewDocument(testCase.Path, "test")
+			doc.ResourceName = "test_thing"
+
+			if err := doc.Parse(); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			var got []string
+
+			for _, d := range diagnostic.FromError(doc.Check(&CheckOptions{Schema: testCase.Schema})) {
+				got = append(got, fmt.Sprintf("%d:%d: %s", d.Line, d.Column, d.Message))
+			}
+
+			if !slices.Equal(got, testCase.Expect) {
+				t.Errorf("expected %q, got %q", testCase.Expect, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,216 @@
+// Copyright IBM Corp. 2019, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package contents
+
+import (
+	"maps"
+	"slices"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/yuin/goldmark/ast"
+)
+
+// exampleMetaArguments are the arguments and blocks of each configuration
+// block type which Terraform handles, rather than the provider schema.
+var exampleMetaArguments = map[string][]string{
+	"action":    {"config", "count", "for_each", "provider"},
+	"data":      {"count", "depends_on", "for_each", "lifecycle", "provider"},
+	"ephemeral": {"count", "depends_on", "for_each", "lifecycle", "provider"},
+	"list":      {"config", "count", "for_each", "include_resource", "limit", "provider"},
+	"resource":  {"connection", "count", "depends_on", "for_each", "lifecycle", "provider", "provisioner"},
+}
+
+// exampleConfigBlockTypes are the configuration block types whose schema
+// arguments are configured in a nested config block.
+var exampleConfigBlockTypes = []string{"action", "list"}
+
+// checkExampleSchema verifies that the configuration blocks of the given
+// block type (e.g. resource) in the terraform and hcl fenced code blocks only
+// set arguments of their schema, set all required arguments, and do not set
+// computed-only attributes. Expressions are not evaluated. Code blocks with
+// syntax errors and types without a schema are skipped.
+func (d *Document) checkExampleSchema(fencedCodeBlocks []*ast.FencedCodeBlock, blockType string, schemas map[string]*tfjson.SchemaBlock) error {
+	var result *multierror.Error
+
+	for _, fencedCodeBlock := range fencedCodeBlocks {
+		if !isHCLCodeBlock(fencedCodeBlock, d.source) {
+			continue
+		}
+
+		file, diags := hclsyntax.ParseConfig(hclCodeBlockText(fencedCodeBlock, d.source), d.path, hcl.InitialPos)
+
+		if diags.HasErrors() {
+			continue
+		}
+
+		body, ok := file.Body.(*hclsyntax.Body)
+
+		if !ok {
+			continue
+		}
+
+		for _, block := range body.Blocks {
+			if block.Type != blockType || len(block.Labels) == 0 {
+				continue
+			}
+
+			schema, ok := schemas[block.Labels[0]]
+
+			if !ok || schema == nil {
+				continue
+			}
+
+			checker := &exampleSchemaChecker{
+				document:        d,
+				fencedCodeBlock: fencedCodeBlock,
+				typeName:        block.Labels[0],
+			}
+
+			if slices.Contains(exampleConfigBlockTypes, blockType) {
+				checker.checkConfigBlock(block, schema)
+			} else {
+				checker.checkBody(block.Body, &block.TypeRange, schema, "", exampleMetaArguments[blockType])
+			}
+
+			result = multierror.Append(result, checker.result)
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+// exampleSchemaChecker collects findings of a single configuration block.
+type exampleSchemaChecker struct {
+	document        *Document
+	fencedCodeBlock *ast.FencedCodeBlock
+	result          *multierror.Error
+	typeName        string
+}
+
+func (c *exampleSchemaChecker) append(subject *hcl.Range, format string, a ...any) {
+	c.result = multierror.Append(c.result, c.document.codeBlockDiagnostic(c.fencedCodeBlock, subject, RuleExampleSchema, format, a...))
+}
+
+// checkConfigBlock verifies the nested config block, which holds the schema
+// arguments of action and list configuration blocks.
+func (c *exampleSchemaChecker) checkConfigBlock(block *hclsyntax.Block, schema *tfjson.SchemaBlock) {
+	for _, nestedBlock := range block.Body.Blocks {
+		if nestedBlock.Type == "config" {
+			c.checkBody(nestedBlock.Body, &nestedBlock.TypeRange, schema, "", nil)
+			return
+		}
+	}
+
+	c.checkMissing(block.Body, &block.TypeRange, schema, "")
+}
+
+// checkBody verifies the arguments and nested blocks of a body against the
+// schema block. The path prefixes nested argument names (e.g. rule.), and
+// ignored names are meta-arguments handled by Terraform.
+func (c *exampleSchemaChecker) checkBody(body *hclsyntax.Body, subject *hcl.Range, schema *tfjson.SchemaBlock, path string, ignored []string) {
+	attributes := slices.SortedFunc(maps.Values(body.Attributes), func(a, b *hclsyntax.Attribute) int {
+		return a.SrcRange.Start.Byte - b.SrcRange.Start.Byte
+	})
+
+	for _, attribute := range attributes {
+		if slices.Contains(ignored, attribute.Name) {
+			continue
+		}
+
+		if schemaAttribute, ok := schema.Attributes[attribute.Name]; ok {
+			if schemaAttribute.Computed && !schemaAttribute.Optional && !schemaAttribute.Required {
+				c.append(&attribute.NameRange, "example section %s configuration sets argument (%s%s) that is computed-only in schema", c.typeName, path, attribute.Name)
+			}
+
+			continue
+		}
+
+		if _, ok := schema.NestedBlocks[attribute.Name]; ok {
+			continue
+		}
+
+		c.append(&attribute.NameRange, "example section %s configuration contains argument (%s%s) not found in schema", c.typeName, path, attribute.Name)
+	}
+
+	for _, block := range body.Blocks {
+		if slices.Contains(ignored, block.Type) {
+			continue
+		}
+
+		name := block.Type
+		nameSubject := &block.TypeRange
+		nestedBody := block.Body
+		nestedSubject := &block.TypeRange
+
+		// Dynamic blocks configure the labeled nested block with the body of
+		// their content block.
+		if block.Type == "dynamic" && len(block.Labels) > 0 {
+			name = block.Labels[0]
+			nameSubject = &block.LabelRanges[0]
+			nestedBody = nil
+
+			for _, contentBlock := range block.Body.Blocks {
+				if contentBlock.Type == "content" {
+					nestedBody = contentBlock.Body
+					nestedSubject = &contentBlock.TypeRange
+				}
+			}
+		}
+
+		if nestedBlock, ok := schema.NestedBlocks[name]; ok {
+			if nestedBody != nil && nestedBlock.Block != nil {
+				c.checkBody(nestedBody, nestedSubject, nestedBlock.Block, path+name+".", nil)
+			}
+
+			continue
+		}
+
+		// Attributes may also be configured with block syntax.
+		if _, ok := schema.Attributes[name]; ok {
+			continue
+		}
+
+		c.append(nameSubject, "example section %s configuration contains block (%s%s) not found in schema", c.typeName, path, name)
+	}
+
+	c.checkMissing(body, subject, schema, path)
+}
+
+// checkMissing verifies that a body sets the required attributes and nested
+// blocks of the schema block. Findings are reported at the subject.
+func (c *exampleSchemaChecker) checkMissing(body *hclsyntax.Body, subject *hcl.Range, schema *tfjson.SchemaBlock, path string) {
+	configured := make(map[string]bool)
+
+	for name := range body.Attributes {
+		configured[name] = true
+	}
+
+	for _, block := range body.Blocks {
+		if block.Type == "dynamic" && len(block.Labels) > 0 {
+			configured[block.Labels[0]] = true
+			continue
+		}
+
+		configured[block.Type] = true
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(schema.Attributes)) {
+		if !schema.Attributes[name].Required || configured[name] {
+			continue
+		}
+
+		c.append(subject, "example section %s configuration missing required argument: %s%s", c.typeName, path, name)
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(schema.NestedBlocks)) {
+		if schema.NestedBlocks[name].MinItems == 0 || configured[name] {
+			continue
+		}
+
+		c.append(subject, "example section %s configuration missing required block: %s%s", c.typeName, path, name)
+	}
+}
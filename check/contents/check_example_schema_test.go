@@ -0,0 +1,230 @@
+// Copyright IBM Corp. 2019, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package contents
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/YakDriver/tfproviderdocs/check/diagnostic"
+	"github.com/hashicorp/go-multierror"
+	tfjson "github.com/hashicorp/terraform-json"
+)
+
+func TestCheckExampleSchema(t *testing.T) {
+	schemas := map[string]*tfjson.SchemaBlock{
+		"test_thing": {
+			Attributes: map[string]*tfjson.SchemaAttribute{
+				"arn":  {Computed: true},
+				"id":   {Computed: true, Optional: true},
+				"name": {Required: true},
+				"tags": {Optional: true},
+			},
+			NestedBlocks: map[string]*tfjson.SchemaBlockType{
+				"rule": {
+					Block: &tfjson.SchemaBlock{
+						Attributes: map[string]*tfjson.SchemaAttribute{
+							"action": {Required: true},
+						},
+					},
+					MinItems: 1,
+				},
+			},
+		},
+	}
+
+	testCases := []struct {
+		Name      string
+		BlockType string
+		Schemas   map[string]*tfjson.SchemaBlock
+		Source    string
+		Expect    []string
+	}{
+		{
+			Name:      "valid",
+			BlockType: "resource",
+			Schemas:   schemas,
+			Source: "```terraform\n" +
+				"resource \"test_thing\" \"example\" {\n" +
+				"  count = 2\n" +
+				"  name  = \"example-${count.index}\"\n" +
+				"\n" +
+				"  rule {\n" +
+				"    action = var.action\n" +
+				"  }\n" +
+				"\n" +
+				"  lifecycle {\n" +
+				"    create_before_destroy = true\n" +
+				"  }\n" +
+				"}\n" +
+				"```\n",
+		},
+		{
+			Name:      "dynamic block",
+			BlockType: "resource",
+			Schemas:   schemas,
+			Source: "```terraform\n" +
+				"resource \"test_thing\" \"example\" {\n" +
+				"  name = \"example\"\n" +
+				"\n" +
+				"  dynamic \"rule\" {\n" +
+				"    for_each = var.rules\n" +
+				"    content {\n" +
+				"      action = rule.value\n" +
+				"      other  = rule.key\n" +
+				"    }\n" +
+				"  }\n" +
+				"}\n" +
+				"```\n",
+			Expect: []string{
+				"11:7: example section test_thing configuration contains argument (rule.other) not found in schema",
+			},
+		},
+		{
+			Name:      "invalid arguments",
+			BlockType: "resource",
+			Schemas:   schemas,
+			Source: "```terraform\n" +
+				"resource \"test_thing\" \"example\" {\n" +
+				"  arn   = \"arn:test\"\n" +
+				"  other = true\n" +
+				"\n" +
+				"  rule {\n" +
+				"  }\n" +
+				"\n" +
+				"  unknown {\n" +
+				"  }\n" +
+				"}\n" +
+				"```\n",
+			Expect: []string{
+				"5:3: example section test_thing configuration sets argument (arn) that is computed-only in schema",
+				"6:3: example section test_thing configuration contains argument (other) not found in schema",
+				"8:3: example section test_thing configuration missing required argument: rule.action",
+				"11:3: example section test_thing configuration contains block (unknown) not found in schema",
+				"4:1: example section test_thing configuration missing required argument: name",
+			},
+		},
+		{
+			Name:      "missing required block",
+			BlockType: "resource",
+			Schemas:   schemas,
+			Source: "```terraform\n" +
+				"resource \"test_thing\" \"example\" {\n" +
+				"  name = \"example\"\n" +
+				"}\n" +
+				"```\n",
+			Expect: []string{
+				"4:1: example section test_thing configuration missing required block: rule",
+			},
+		},
+		{
+			Name:      "other block type",
+			BlockType: "data",
+			Schemas:   schemas,
+			Source: "```terraform\n" +
+				"resource \"test_thing\" \"example\" {\n" +
+				"  other = true\n" +
+				"}\n" +
+				"```\n",
+		},
+		{
+			Name:      "unknown type",
+			BlockType: "resource",
+			Schemas:   schemas,
+			Source: "```terraform\n" +
+				"resource \"test_other\" \"example\" {\n" +
+				"  other = true\n" +
+				"}\n" +
+				"```\n",
+		},
+		{
+			Name:      "syntax error",
+			BlockType: "resource",
+			Schemas:   schemas,
+			Source: "```terraform\n" +
+				"resource \"test_thing\" \"example\" {\n" +
+				"  other = = true\n" +
+				"}\n" +
+				"```\n",
+		},
+		{
+			Name:      "action config",
+			BlockType: "action",
+			Schemas: map[string]*tfjson.SchemaBlock{
+				"test_invoke": {
+					Attributes: map[string]*tfjson.SchemaAttribute{
+						"function": {Required: true},
+					},
+				},
+			},
+			Source: "```terraform\n" +
+				"action \"test_invoke\" \"example\" {\n" +
+				"  config {\n" +
+				"    payload = \"{}\"\n" +
+				"  }\n" +
+				"}\n" +
+				"```\n",
+			Expect: []string{
+				"6:5: example section test_invoke configuration contains argument (payload) not found in schema",
+				"5:3: example section test_invoke configuration missing required argument: function",
+			},
+		},
+		{
+			Name:      "list missing config",
+			BlockType: "list",
+			Schemas: map[string]*tfjson.SchemaBlock{
+				"test_thing": {
+					Attributes: map[string]*tfjson.SchemaAttribute{
+						"region": {Required: true},
+					},
+				},
+			},
+			Source: "```terraform\n" +
+				"list \"test_thing\" \"example\" {\n" +
+				"  provider = test\n" +
+				"}\n" +
+				"```\n",
+			Expect: []string{
+				"4:1: example section test_thing configuration missing required argument: region",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			doc := NewDocument("thing.md", "test")
+
+			if err := doc.ParseSource([]byte("## Example Usage\n\n" + testCase.Source)); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			err := doc.checkExampleSchema(doc.Sections.Example.FencedCodeBlocks, testCase.BlockType, testCase.Schemas)
+
+			var got []string
+			var merr *multierror.Error
+
+			if errors.As(err, &merr) {
+				for _, err := range merr.Errors {
+					var d *diagnostic.Diagnostic
+
+					if !errors.As(err, &d) {
+						t.Fatalf("expected diagnostic, got: %T", err)
+					}
+
+					if d.Rule != RuleExampleSchema {
+						t.Errorf("expected rule %s, got %s", RuleExampleSchema, d.Rule)
+					}
+
+					got = append(got, fmt.Sprintf("%d:%d: %s", d.Line, d.Column, d.Message))
+				}
+			}
+
+			if !slices.Equal(got, testCase.Expect) {
+				t.Errorf("expected findings:\n%q\n\ngot:\n%q", testCase.Expect, got)
+			}
+		})
+	}
+}
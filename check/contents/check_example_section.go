@@ -4,14 +4,22 @@
 package contents
 
 import (
-	"fmt"
 	"strings"
 
 	"github.com/YakDriver/tfproviderdocs/markdown"
+	"github.com/hashicorp/go-multierror"
+	tfjson "github.com/hashicorp/terraform-json"
 )
 
 type CheckExamplesSectionOptions struct {
+	// BlockType is the Terraform configuration block type (e.g. resource or
+	// data) of the code block examples validated against Schemas.
+	BlockType                 string
 	ExpectedCodeBlockLanguage string
+
+	// Schemas contains provider schema blocks keyed by type name, which
+	// enables validation of example configuration blocks.
+	Schemas map[string]*tfjson.SchemaBlock
 }
 
 func (d *Document) checkExampleSection() error {
@@ -26,32 +34,44 @@ func (d *Document) checkExampleSection() error {
 	section := d.Sections.Example
 
 	if section == nil {
-		return fmt.Errorf("missing example section: ## Example Usage")
+		return d.diagnostic(nil, RuleExampleSection, "missing example section: ## Example Usage")
 	}
 
+	var result *multierror.Error
+
 	heading := section.Heading
 
 	if heading.Level != 2 {
-		return fmt.Errorf("example section heading level (%d) should be: 2", heading.Level)
+		result = multierror.Append(result, d.diagnostic(heading, RuleExampleSection, "example section heading level (%d) should be: 2", heading.Level))
 	}
 
 	headingText := string(heading.Text(d.source))
 	expectedHeadingText := "Example Usage"
 
 	if headingText != expectedHeadingText {
-		return fmt.Errorf("example section heading (%s) should be: %s", headingText, expectedHeadingText)
+		result = multierror.Append(result, d.diagnostic(heading, RuleExampleSection, "example section heading (%s) should be: %s", headingText, expectedHeadingText))
+	}
+
+	if err := d.checkHCLSyntax("example", section.FencedCodeBlocks); err != nil {
+		result = multierror.Append(result, err)
+	}
+
+	if checkOpts.BlockType != "" && len(checkOpts.Schemas) > 0 {
+		if err := d.checkExampleSchema(section.FencedCodeBlocks, checkOpts.BlockType, checkOpts.Schemas); err != nil {
+			result = multierror.Append(result, err)
+		}
 	}
 
 	// CDKTF conversion will leave the original terraform code blocks if unsuccessful
 	if checkOpts.ExpectedCodeBlockLanguage != markdown.FencedCodeBlockLanguageTerraform {
-		return nil
+		return result.ErrorOrNil()
 	}
 
 	for _, fencedCodeBlock := range section.FencedCodeBlocks {
 		language := markdown.FencedCodeBlockLanguage(fencedCodeBlock, d.source)
 
 		if language != checkOpts.ExpectedCodeBlockLanguage {
-			return fmt.Errorf("example section code block language (%s) should be: ```%s", language, checkOpts.ExpectedCodeBlockLanguage)
+			result = multierror.Append(result, d.diagnostic(fencedCodeBlock, RuleExampleSection, "example section code block language (%s) should be: ```%s", language, checkOpts.ExpectedCodeBlockLanguage))
 		}
 
 		text := markdown.FencedCodeBlockText(fencedCodeBlock, d.source)
@@ -65,9 +85,9 @@ func (d *Document) checkExampleSection() error {
 		}
 
 		if !strings.Contains(text, d.ResourceName) && !strings.Contains(text, altResourceName) {
-			return fmt.Errorf("example section code block text should contain resource name: %s", d.ResourceName)
+			result = multierror.Append(result, d.diagnostic(fencedCodeBlock, RuleExampleSection, "example section code block text should contain resource name: %s", d.ResourceName))
 		}
 	}
 
-	return nil
+	return result.ErrorOrNil()
 }
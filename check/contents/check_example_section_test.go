@@ -54,6 +54,12 @@ func TestCheckExampleSection(t *testing.T) {
 			ProviderName: "test",
 			ExpectError:  true,
 		},
+		{
+			Name:         "wrong hcl syntax",
+			Path:         "testdata/example/wrong_hcl_syntax.md",
+			ProviderName: "test",
+			ExpectError:  true,
+		},
 	}
 
 	for _, testCase := range testCases {
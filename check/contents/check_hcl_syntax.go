@@ -0,0 +1,106 @@
+// Copyright IBM Corp. 2019, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package contents
+
+import (
+	"bytes"
+	"slices"
+
+	"github.com/YakDriver/tfproviderdocs/check/diagnostic"
+	"github.com/YakDriver/tfproviderdocs/markdown"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/text"
+)
+
+// hclCodeBlockLanguages are the fenced code block languages parsed as HCL
+// native syntax.
+var hclCodeBlockLanguages = []string{
+	markdown.FencedCodeBlockLanguageHcl,
+	markdown.FencedCodeBlockLanguageTerraform,
+}
+
+// checkHCLSyntax verifies that the terraform and hcl fenced code blocks of the
+// section parse as HCL native syntax. Findings are positioned at the line of
+// the syntax error within the documentation file.
+func (d *Document) checkHCLSyntax(sectionName string, fencedCodeBlocks []*ast.FencedCodeBlock) error {
+	var result *multierror.Error
+
+	for _, fencedCodeBlock := range fencedCodeBlocks {
+		if !isHCLCodeBlock(fencedCodeBlock, d.source) {
+			continue
+		}
+
+		_, diags := hclsyntax.ParseConfig(hclCodeBlockText(fencedCodeBlock, d.source), d.path, hcl.InitialPos)
+
+		for _, diag := range diags {
+			if diag.Severity != hcl.DiagError {
+				continue
+			}
+
+			message := diag.Summary
+
+			if diag.Detail != "" {
+				message += ": " + diag.Detail
+			}
+
+			result = multierror.Append(result, d.codeBlockDiagnostic(fencedCodeBlock, diag.Subject, RuleHCLSyntax, "%s section code block is not valid HCL: %s", sectionName, message))
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+// codeBlockDiagnostic returns a finding positioned at the start of the range
+// within the code block, or at the code block if the range is nil.
+func (d *Document) codeBlockDiagnostic(fencedCodeBlock *ast.FencedCodeBlock, subject *hcl.Range, rule string, format string, a ...any) *diagnostic.Diagnostic {
+	result := d.diagnostic(fencedCodeBlock, rule, format, a...)
+
+	if lines := fencedCodeBlock.Lines(); subject != nil && lines.Len() > 0 {
+		result.Line, result.Column = markdown.OffsetPosition(d.source, codeBlockOffset(lines, d.source, subject.Start.Byte))
+	}
+
+	return result
+}
+
+// isHCLCodeBlock returns whether the fenced code block language is parsed as
+// HCL native syntax.
+func isHCLCodeBlock(fencedCodeBlock *ast.FencedCodeBlock, source []byte) bool {
+	return slices.Contains(hclCodeBlockLanguages, markdown.FencedCodeBlockLanguage(fencedCodeBlock, source))
+}
+
+// hclCodeBlockText returns the unmodified text of the code block lines, so
+// HCL positions correspond to the code block lines.
+func hclCodeBlockText(fencedCodeBlock *ast.FencedCodeBlock, source []byte) []byte {
+	lines := fencedCodeBlock.Lines()
+	var result bytes.Buffer
+
+	for i := 0; i < lines.Len(); i++ {
+		segment := lines.At(i)
+		result.Write(segment.Value(source))
+	}
+
+	return result.Bytes()
+}
+
+// codeBlockOffset returns the source byte offset of a byte offset into the
+// concatenated lines of a code block. Offsets past the end of the code block
+// are positioned at the end of its last line.
+func codeBlockOffset(lines *text.Segments, source []byte, offset int) int {
+	for i := 0; i < lines.Len(); i++ {
+		segment := lines.At(i)
+		length := len(segment.Value(source))
+
+		if offset < length || i == lines.Len()-1 {
+			// Padding replaces tabs with spaces, which do not exist in the source.
+			return segment.Start + min(max(offset-segment.Padding, 0), max(segment.Len()-1, 0))
+		}
+
+		offset -= length
+	}
+
+	return 0
+}
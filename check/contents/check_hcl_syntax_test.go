@@ -0,0 +1,110 @@
+// Copyright IBM Corp. 2019, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package contents
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/YakDriver/tfproviderdocs/check/diagnostic"
+	"github.com/hashicorp/go-multierror"
+	"github.com/yuin/goldmark/ast"
+)
+
+func TestCheckHCLSyntax(t *testing.T) {
+	testCases := []struct {
+		Name         string
+		Source       string
+		ExpectLine   int
+		ExpectColumn int
+	}{
+		{
+			Name: "valid",
+			Source: "## Example Usage\n" +
+				"\n" +
+				"```terraform\n" +
+				"resource \"test_thing\" \"example\" {\n" +
+				"  name = \"example\"\n" +
+				"}\n" +
+				"```\n",
+		},
+		{
+			Name: "not hcl language",
+			Source: "## Example Usage\n" +
+				"\n" +
+				"```console\n" +
+				"$ terraform apply {\n" +
+				"```\n",
+		},
+		{
+			Name: "invalid attribute",
+			Source: "## Example Usage\n" +
+				"\n" +
+				"```hcl\n" +
+				"resource \"test_thing\" \"example\" {\n" +
+				"  name = = \"example\"\n" +
+				"}\n" +
+				"```\n",
+			ExpectLine:   5,
+			ExpectColumn: 10,
+		},
+		{
+			Name: "unclosed block in blockquote",
+			Source: "## Example Usage\n" +
+				"\n" +
+				"> ```terraform\n" +
+				"> resource \"test_thing\" \"example\" {\n" +
+				">   name = \"example\"\n" +
+				"> ```\n",
+			ExpectLine:   4,
+			ExpectColumn: 35,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			doc := NewDocument("thing.md", "test")
+
+			if err := doc.ParseSource([]byte(testCase.Source)); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			var fencedCodeBlocks []*ast.FencedCodeBlock
+
+			if doc.Sections.Example != nil {
+				fencedCodeBlocks = doc.Sections.Example.FencedCodeBlocks
+			}
+
+			err := doc.checkHCLSyntax("example", fencedCodeBlocks)
+
+			if testCase.ExpectLine == 0 {
+				if err != nil {
+					t.Errorf("expected no error, got error: %s", err)
+				}
+
+				return
+			}
+
+			var merr *multierror.Error
+
+			if !errors.As(err, &merr) || len(merr.Errors) == 0 {
+				t.Fatalf("expected error, got: %v", err)
+			}
+
+			var d *diagnostic.Diagnostic
+
+			if !errors.As(merr.Errors[0], &d) {
+				t.Fatalf("expected diagnostic, got: %T", merr.Errors[0])
+			}
+
+			if d.Rule != RuleHCLSyntax {
+				t.Errorf("expected rule %s, got %s", RuleHCLSyntax, d.Rule)
+			}
+
+			if d.Line != testCase.ExpectLine || d.Column != testCase.ExpectColumn {
+				t.Errorf("expected position %d:%d, got %d:%d: %s", testCase.ExpectLine, testCase.ExpectColumn, d.Line, d.Column, d)
+			}
+		})
+	}
+}
@@ -4,10 +4,10 @@
 package contents
 
 import (
-	"fmt"
 	"strings"
 
 	"github.com/YakDriver/tfproviderdocs/markdown"
+	"github.com/hashicorp/go-multierror"
 )
 
 type CheckImportSectionOptions struct {
@@ -25,27 +25,29 @@ func (d *Document) checkImportSection() error {
 
 	if section == nil {
 		if checkOpts.RequireSection == Required {
-			return fmt.Errorf("missing import section: ## Import")
+			return d.diagnostic(nil, RuleImportSection, "missing import section: ## Import")
 		}
 
 		return nil
 	} else {
 		if checkOpts.RequireSection == Forbidden {
-			return fmt.Errorf("import section should not be present")
+			return d.diagnostic(section.Heading, RuleImportSection, "import section should not be present")
 		}
 	}
 
+	var result *multierror.Error
+
 	heading := section.Heading
 
 	if heading.Level != 2 {
-		return fmt.Errorf("import section heading level (%d) should be: 2", heading.Level)
+		result = multierror.Append(result, d.diagnostic(heading, RuleImportSection, "import section heading level (%d) should be: 2", heading.Level))
 	}
 
 	headingText := string(heading.Text(d.source))
 	expectedHeadingText := "Import"
 
 	if headingText != expectedHeadingText {
-		return fmt.Errorf("import section heading (%s) should be: %s", headingText, expectedHeadingText)
+		result = multierror.Append(result, d.diagnostic(heading, RuleImportSection, "import section heading (%s) should be: %s", headingText, expectedHeadingText))
 	}
 
 	paragraphs := section.Paragraphs
@@ -71,19 +73,21 @@ func (d *Document) checkImportSection() error {
 			problem := v[0]
 			msg := v[1]
 			if strings.Contains(text, problem) {
-				return fmt.Errorf("import section should not include %q, %s", problem, msg)
+				diag := d.diagnostic(paragraphs[0], RuleImportSection, "import section should not include %q, %s", problem, msg)
+				diag.Suggestion = msg
+				result = multierror.Append(result, diag)
 			}
 		}
 
 		suffix := ". For example:"
 		suffixNewline := ".\nFor example:"
 		if !strings.HasSuffix(text, suffix) && !strings.HasSuffix(text, suffixNewline) && !strings.Contains(text, "cannot import") {
-			return fmt.Errorf("import section should conclude with %q (or state \"You cannot import ...\")", suffix)
+			result = multierror.Append(result, d.diagnostic(paragraphs[0], RuleImportSection, "import section should conclude with %q (or state \"You cannot import ...\")", suffix))
 		}
 	}
 
 	if len(paragraphs) > 0 && !strings.Contains(string(paragraphs[0].Text(d.source)), "cannot import") && len(section.FencedCodeBlocks) < 1 {
-		return fmt.Errorf("import section should have a code block (or state \"You cannot import ...\")")
+		result = multierror.Append(result, d.diagnostic(heading, RuleImportSection, "import section should have a code block (or state \"You cannot import ...\")"))
 	}
 
 	hitConsole := false
@@ -91,19 +95,19 @@ func (d *Document) checkImportSection() error {
 		text := markdown.FencedCodeBlockText(fencedCodeBlock, d.source)
 
 		if !strings.Contains(text, d.ResourceName) {
-			return fmt.Errorf("import section code block text should contain resource name: %s", d.ResourceName)
+			result = multierror.Append(result, d.diagnostic(fencedCodeBlock, RuleImportSection, "import section code block text should contain resource name: %s", d.ResourceName))
 		}
 
 		if i == 0 && (!strings.Contains(markdown.FencedCodeBlockLanguage(fencedCodeBlock, d.source), "terraform") || !strings.HasPrefix(text, "import {")) {
-			return fmt.Errorf("the first import section code block should have an import block using type 'terraform' (i.e., ```terraform\nimport {)")
+			result = multierror.Append(result, d.diagnostic(fencedCodeBlock, RuleImportSection, "the first import section code block should have an import block using type 'terraform' (i.e., ```terraform\nimport {)"))
 		}
 
 		if strings.Contains(markdown.FencedCodeBlockLanguage(fencedCodeBlock, d.source), "console") && !strings.HasPrefix(text, "% ") {
-			return fmt.Errorf("import section code block type 'console' should begin with '%% '")
+			result = multierror.Append(result, d.diagnostic(fencedCodeBlock, RuleImportSection, "import section code block type 'console' should begin with '%% '"))
 		}
 
 		if !strings.Contains(markdown.FencedCodeBlockLanguage(fencedCodeBlock, d.source), "console") && !strings.Contains(markdown.FencedCodeBlockLanguage(fencedCodeBlock, d.source), "terraform") {
-			return fmt.Errorf("import section code block type should be 'console' or 'terraform' (i.e., ```console or ```terraform)")
+			result = multierror.Append(result, d.diagnostic(fencedCodeBlock, RuleImportSection, "import section code block type should be 'console' or 'terraform' (i.e., ```console or ```terraform)"))
 		}
 
 		if strings.Contains(markdown.FencedCodeBlockLanguage(fencedCodeBlock, d.source), "console") {
@@ -111,9 +115,13 @@ func (d *Document) checkImportSection() error {
 		}
 
 		if hitConsole && strings.Contains(markdown.FencedCodeBlockLanguage(fencedCodeBlock, d.source), "terraform") && strings.HasPrefix(text, "import ") {
-			return fmt.Errorf("import section: all code blocks of type 'terraform' should be before code blocks of type 'console'")
+			result = multierror.Append(result, d.diagnostic(fencedCodeBlock, RuleImportSection, "import section: all code blocks of type 'terraform' should be before code blocks of type 'console'"))
 		}
 	}
 
-	return nil
+	if err := d.checkHCLSyntax("import", section.FencedCodeBlocks); err != nil {
+		result = multierror.Append(result, err)
+	}
+
+	return result.ErrorOrNil()
 }
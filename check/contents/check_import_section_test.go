@@ -94,6 +94,12 @@ func TestCheckImportSection(t *testing.T) {
 			ProviderName: "test",
 			ExpectError:  true,
 		},
+		{
+			Name:         "wrong hcl syntax",
+			Path:         "testdata/import/wrong_hcl_syntax.md",
+			ProviderName: "test",
+			ExpectError:  true,
+		},
 		{
 			Name:         "forbidden",
 			Path:         "testdata/import/passing.md",
@@ -0,0 +1,115 @@
+// Copyright IBM Corp. 2019, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package contents
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/YakDriver/tfproviderdocs/check/diagnostic"
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/zclconf/go-cty/cty"
+)
+
+func TestCheckPluginDocs(t *testing.T) {
+	schema := &tfjson.SchemaBlock{
+		Attributes: map[string]*tfjson.SchemaAttribute{
+			"arn":  {AttributeType: cty.String, Computed: true},
+			"id":   {AttributeType: cty.String, Computed: true},
+			"name": {AttributeType: cty.String, Required: true},
+			"tags": {AttributeType: cty.Map(cty.String), Optional: true},
+		},
+		NestedBlocks: map[string]*tfjson.SchemaBlockType{
+			"rule": {
+				Block: &tfjson.SchemaBlock{
+					Attributes: map[string]*tfjson.SchemaAttribute{
+						"action":  {AttributeType: cty.String, Required: true},
+						"rule_id": {AttributeType: cty.String, Computed: true},
+					},
+					NestedBlocks: map[string]*tfjson.SchemaBlockType{
+						"filter": {
+							Block: &tfjson.SchemaBlock{
+								Attributes: map[string]*tfjson.SchemaAttribute{
+									"prefix": {AttributeType: cty.String, Optional: true},
+								},
+							},
+							MaxItems:    1,
+							NestingMode: tfjson.SchemaNestingModeList,
+						},
+					},
+				},
+				MaxItems:    1,
+				NestingMode: tfjson.SchemaNestingModeList,
+			},
+		},
+	}
+
+	testCases := []struct {
+		Name   string
+		Path   string
+		Expect []string
+	}{
+		{
+			Name: "passing",
+			Path: "testdata/plugin_docs/passing.md",
+		},
+		{
+			Name: "wrong schema",
+			Path: "testdata/plugin_docs/wrong_schema.md",
+			Expect: []string{
+				"44:3: arguments subsection (rule) is not sorted by name",
+				"44:3: arguments section contains argument (rule.status) not found in schema",
+				"61:3: arguments section contains argument (rule.filter.size) not found in schema",
+			},
+		},
+		{
+			Name: "wrong type",
+			Path: "testdata/plugin_docs/wrong_type.md",
+			Expect: []string{
+				"31:3: arguments section argument (rule) trait (Max: 2) does not match schema trait: Max: 1",
+				"32:3: arguments section argument (tags) type (Set of String) does not match schema type: Map of String",
+				"48:3: arguments section argument (rule.filter) type (Block Set) does not match schema type: Block List",
+				"48:3: arguments section argument (rule.filter) missing schema trait: Max: 1",
+				"36:3: attribute section attribute (arn) type (Number) does not match schema type: String",
+				"36:3: attribute section attribute (arn) trait (Sensitive) is not in schema",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			doc := NewDocument(testCase.Path, "test")
+			doc.ResourceName = "test_thing"
+
+			if err := doc.Parse(); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if doc.Layout != LayoutPluginDocs {
+				t.Fatalf("expected layout %q, got %q", LayoutPluginDocs, doc.Layout)
+			}
+
+			checkOpts := &CheckOptions{
+				ArgumentsSection: &CheckArgumentsSectionOptions{
+					RequireSchemaOrdering: true,
+				},
+				AttributesSection: &CheckAttributesSectionOptions{
+					RequireSchemaOrdering: true,
+				},
+				Schema: schema,
+			}
+
+			var got []string
+
+			for _, d := range diagnostic.FromError(doc.Check(checkOpts)) {
+				got = append(got, fmt.Sprintf("%d:%d: %s", d.Line, d.Column, d.Message))
+			}
+
+			if !slices.Equal(got, testCase.Expect) {
+				t.Errorf("expected %q, got %q", testCase.Expect, got)
+			}
+		})
+	}
+}
@@ -7,6 +7,8 @@ import (
 	"fmt"
 	"slices"
 	"strings"
+
+	"github.com/hashicorp/go-multierror"
 )
 
 type CheckSignatureSectionOptions struct {
@@ -25,18 +27,20 @@ func (d *Document) checkSignatureSection() error {
 
 	if section == nil {
 		if opts.RequireSection == Required {
-			return fmt.Errorf("missing signature section: ## Signature")
+			return d.diagnostic(nil, RuleSignatureSection, "missing signature section: ## Signature")
 		}
 		return nil
 	}
 
 	if opts.RequireSection == Forbidden {
-		return fmt.Errorf("signature section should not be present")
+		return d.diagnostic(section.Heading, RuleSignatureSection, "signature section should not be present")
 	}
 
+	var result *multierror.Error
+
 	heading := section.Heading
 	if heading.Level != 2 {
-		return fmt.Errorf("signature section heading level (%d) should be: 2", heading.Level)
+		result = multierror.Append(result, d.diagnostic(heading, RuleSignatureSection, "signature section heading level (%d) should be: 2", heading.Level))
 	}
 
 	headingText := string(heading.Text(d.source))
@@ -53,12 +57,12 @@ func (d *Document) checkSignatureSection() error {
 		for i, v := range allowedHeadingTexts {
 			formatted[i] = fmt.Sprintf("%q", v)
 		}
-		return fmt.Errorf("signature section heading (%s) should be one of: %s", headingText, strings.Join(formatted, ", "))
+		result = multierror.Append(result, d.diagnostic(heading, RuleSignatureSection, "signature section heading (%s) should be one of: %s", headingText, strings.Join(formatted, ", ")))
 	}
 
 	if opts.RequireCodeBlock && len(section.FencedCodeBlocks) == 0 {
-		return fmt.Errorf("signature section must include a code block")
+		result = multierror.Append(result, d.diagnostic(heading, RuleSignatureSection, "signature section must include a code block"))
 	}
 
-	return nil
+	return result.ErrorOrNil()
 }
@@ -0,0 +1,130 @@
+// Copyright IBM Corp. 2019, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package contents
+
+import (
+	"sort"
+	"strings"
+
+	tfjson "github.com/hashicorp/terraform-json"
+)
+
+// checkSubsectionByline verifies that a nested block section with a list has
+// a byline introducing the list, such as "The `rule` block supports the
+// following:". The noun (e.g. arguments) prefixes finding messages.
+func (d *Document) checkSubsectionByline(section *SchemaAttributeSection, rule string, noun string, name string) error {
+	if len(section.SchemaAttributeLists) == 0 {
+		return nil
+	}
+
+	if len(section.Paragraphs) == 0 {
+		return d.diagnostic(section.Heading, rule, "%s subsection (%s) byline should introduce the list, e.g.: The `%s` block supports the following:", noun, name, section.Name)
+	}
+
+	paragraphText := string(section.Paragraphs[0].Text(d.source))
+
+	if !strings.HasSuffix(paragraphText, ":") {
+		return d.diagnostic(section.Paragraphs[0], rule, "%s subsection (%s) byline (%s) should introduce the list ending with: :", noun, name, paragraphText)
+	}
+
+	return nil
+}
+
+// unsortedSchemaAttributeList returns the first list with items not sorted by
+// name, if any.
+func unsortedSchemaAttributeList(lists []*SchemaAttributeList) *SchemaAttributeList {
+	for _, list := range lists {
+		if !sort.IsSorted(SchemaAttributeListItemByName(list.Items)) {
+			return list
+		}
+	}
+
+	return nil
+}
+
+// nestedSchemaBlock returns the schema of the named nested block, or of the
+// named nested attribute, of the block. It returns nil if the block is nil or
+// has no such nested block or attribute.
+func nestedSchemaBlock(block *tfjson.SchemaBlock, name string) *tfjson.SchemaBlock {
+	if block == nil {
+		return nil
+	}
+
+	if nestedBlock, ok := block.NestedBlocks[name]; ok {
+		return nestedBlock.Block
+	}
+
+	if attribute, ok := block.Attributes[name]; ok && attribute.AttributeNestedType != nil {
+		return &tfjson.SchemaBlock{
+			Attributes: attribute.AttributeNestedType.Attributes,
+		}
+	}
+
+	return nil
+}
+
+// findNestedSchemaBlocks returns the schemas, by path (e.g. rule.filter), of
+// the nested blocks, or nested attributes, of the name below the block.
+// Dotted names, such as the Nested Schema headings of terraform-plugin-docs,
+// are resolved only as a full path from the block. Other names may match
+// nested blocks at any depth, which callers should report as ambiguous.
+func findNestedSchemaBlocks(block *tfjson.SchemaBlock, name string) map[string]*tfjson.SchemaBlock {
+	result := make(map[string]*tfjson.SchemaBlock)
+
+	if strings.Contains(name, ".") {
+		nestedBlock := block
+
+		for part := range strings.SplitSeq(name, ".") {
+			nestedBlock = nestedSchemaBlock(nestedBlock, part)
+		}
+
+		if nestedBlock != nil {
+			result[name] = nestedBlock
+		}
+
+		return result
+	}
+
+	var walk func(block *tfjson.SchemaBlock, path string)
+
+	walk = func(block *tfjson.SchemaBlock, path string) {
+		if block == nil {
+			return
+		}
+
+		if nestedBlock := nestedSchemaBlock(block, name); nestedBlock != nil {
+			result[path+name] = nestedBlock
+		}
+
+		for nestedName := range block.NestedBlocks {
+			walk(nestedSchemaBlock(block, nestedName), path+nestedName+".")
+		}
+
+		for nestedName := range block.Attributes {
+			walk(nestedSchemaBlock(block, nestedName), path+nestedName+".")
+		}
+	}
+
+	walk(block, "")
+
+	return result
+}
+
+// configurableSchemaBlock returns whether the block has Required or Optional
+// attributes, directly or in nested blocks.
+func configurableSchemaBlock(block *tfjson.SchemaBlock) bool {
+	for _, attribute := range block.Attributes {
+		if attribute.Required || attribute.Optional {
+			return true
+		}
+	}
+
+	for _, blockType := range block.NestedBlocks {
+		if blockType.Block != nil && configurableSchemaBlock(blockType.Block) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -4,16 +4,23 @@
 package contents
 
 import (
+	"errors"
+	"fmt"
+	"slices"
 	"testing"
+
+	"github.com/YakDriver/tfproviderdocs/check/diagnostic"
+	"github.com/hashicorp/go-multierror"
 )
 
 func TestCheck(t *testing.T) {
 	testCases := []struct {
-		Name         string
-		Path         string
-		ProviderName string
-		CheckOptions *CheckOptions
-		ExpectError  bool
+		Name             string
+		Path             string
+		ProviderName     string
+		CheckOptions     *CheckOptions
+		ExpectError      bool
+		ExpectErrorCount int
 	}{
 		{
 			Name:         "passing",
@@ -49,6 +56,13 @@ func TestCheck(t *testing.T) {
 			},
 			ExpectError: true,
 		},
+		{
+			Name:             "multiple errors",
+			Path:             "testdata/multiple_errors.md",
+			ProviderName:     "test",
+			ExpectError:      true,
+			ExpectErrorCount: 5,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -68,6 +82,42 @@ func TestCheck(t *testing.T) {
 			if got != nil && !testCase.ExpectError {
 				t.Errorf("expected no error, got error: %s", got)
 			}
+
+			var merr *multierror.Error
+
+			if testCase.ExpectErrorCount > 0 && errors.As(got, &merr) && len(merr.Errors) != testCase.ExpectErrorCount {
+				t.Errorf("expected %d errors, got %d errors: %s", testCase.ExpectErrorCount, len(merr.Errors), got)
+			}
 		})
 	}
 }
+
+func TestDocumentCheckDiagnostics(t *testing.T) {
+	doc := NewDocument("testdata/multiple_errors.md", "test")
+
+	if err := doc.Parse(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := []string{
+		"example-section:15:1",
+		"arguments-byline:25:1",
+		"import-section:37:1",
+		"import-section:37:1",
+		"import-section:37:1",
+	}
+
+	var got []string
+
+	for _, d := range diagnostic.FromError(doc.Check(nil)) {
+		if d.Path != "testdata/multiple_errors.md" {
+			t.Errorf("expected path testdata/multiple_errors.md, got %s", d.Path)
+		}
+
+		got = append(got, fmt.Sprintf("%s:%d:%d", d.Rule, d.Line, d.Column))
+	}
+
+	if !slices.Equal(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+}
@@ -3,8 +3,6 @@
 
 package contents
 
-import "fmt"
-
 type CheckTimeoutsSectionOptions struct {
 	RequireSection SectionRequirement
 }
@@ -20,12 +18,12 @@ func (d *Document) checkTimeoutsSection() error {
 
 	if section == nil {
 		if checkOpts.RequireSection == Required {
-			return fmt.Errorf("missing timeouts section: ## Timeouts")
+			return d.diagnostic(nil, RuleTimeoutsSection, "missing timeouts section: ## Timeouts")
 		}
 		return nil
 	} else {
 		if checkOpts.RequireSection == Forbidden {
-			return fmt.Errorf("timeouts section should not be present")
+			return d.diagnostic(section.Heading, RuleTimeoutsSection, "timeouts section should not be present")
 		}
 	}
 
@@ -6,19 +6,23 @@ package contents
 import (
 	"fmt"
 	"strings"
+
+	"github.com/hashicorp/go-multierror"
 )
 
 func (d *Document) checkTitleSection() error {
 	section := d.Sections.Title
 
 	if section == nil {
-		return fmt.Errorf("missing title section: # Resource: %s", d.ResourceName)
+		return d.diagnostic(nil, RuleTitleSection, "missing title section: # Resource: %s", d.ResourceName)
 	}
 
+	var result *multierror.Error
+
 	heading := section.Heading
 
 	if heading.Level != 1 {
-		return fmt.Errorf("title section heading level (%d) should be: 1", heading.Level)
+		result = multierror.Append(result, d.diagnostic(heading, RuleTitleSection, "title section heading level (%d) should be: 1", heading.Level))
 	}
 
 	headingText := string(heading.Text(d.source))
@@ -34,15 +38,25 @@ func (d *Document) checkTitleSection() error {
 			isValidPrefix = true
 			break
 		}
+
+		// terraform-plugin-docs titles end with the kind, e.g. test_thing (Resource).
+		if d.Layout == LayoutPluginDocs && strings.HasSuffix(headingText, ")") && strings.Contains(headingText, fmt.Sprintf(" (%s", prefix)) {
+			isValidPrefix = true
+			break
+		}
 	}
 
 	if !isValidPrefix {
-		return fmt.Errorf("title section heading (%s) should have one of these prefixes: %v", headingText, validPrefixes)
+		result = multierror.Append(result, d.diagnostic(heading, RuleTitleSection, "title section heading (%s) should have one of these prefixes: %v", headingText, validPrefixes))
 	}
 
 	if len(section.FencedCodeBlocks) > 0 {
-		return fmt.Errorf("title section code examples should be in Example Usage section")
+		result = multierror.Append(result, d.diagnostic(section.FencedCodeBlocks[0], RuleTitleSection, "title section code examples should be in Example Usage section"))
+	}
+
+	if err := d.checkTitleDeprecation(section); err != nil {
+		result = multierror.Append(result, err)
 	}
 
-	return nil
+	return result.ErrorOrNil()
 }
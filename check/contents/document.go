@@ -9,18 +9,38 @@ import (
 	"path/filepath"
 	"strings"
 
+	"github.com/YakDriver/tfproviderdocs/check/diagnostic"
 	"github.com/YakDriver/tfproviderdocs/markdown"
 	"github.com/yuin/goldmark/ast"
 )
 
+// Layouts of argument and attribute documentation.
+const (
+	// LayoutReference documents arguments and attributes in Argument
+	// Reference and Attribute Reference sections with bylines.
+	LayoutReference = "reference"
+
+	// LayoutPluginDocs documents arguments and attributes in the Schema
+	// section generated by terraform-plugin-docs, with Required, Optional,
+	// and Read-Only sub-sections and Nested Schema sections.
+	LayoutPluginDocs = "plugin-docs"
+)
+
 type Document struct {
 	CheckOptions *CheckOptions
+
+	// Layout is the documentation layout (e.g. LayoutPluginDocs), which is
+	// detected while parsing unless already set.
+	Layout string
+
 	ProviderName string
 	ResourceName string
 	Sections     *Sections
 
+	// Suppressions contains the inline suppression comment directives.
+	Suppressions []*Suppression
+
 	document ast.Node
-	metadata map[string]any
 	path     string
 	source   []byte
 }
@@ -33,31 +53,65 @@ func NewDocument(path string, providerName string) *Document {
 	}
 }
 
+// Parse reads and parses the documentation file.
 func (d *Document) Parse() error {
-	var err error
-
-	d.source, err = os.ReadFile(d.path)
+	source, err := os.ReadFile(d.path)
 
 	if err != nil {
 		return fmt.Errorf("error reading file (%s): %w", d.path, err)
 	}
 
-	d.document, d.metadata = markdown.Parse(d.source)
+	return d.ParseSource(source)
+}
+
+// ParseSource parses the already read source of the documentation file, so
+// callers which also check other parts of the file only read it once.
+func (d *Document) ParseSource(source []byte) error {
+	var err error
 
-	// d.document.Dump(d.source, 1)
+	d.source = source
+	d.document = markdown.Parse(d.source)
 
-	// fmt.Println(d.metadata["page_title"])
-	// fmt.Println(d.metadata["description"])
+	if d.Layout == "" {
+		d.Layout = detectLayout(d.document, d.source)
+	}
 
-	d.Sections, err = sectionsWalker(d.document, d.source, d.ResourceName)
+	d.Sections, err = sectionsWalker(d.document, d.source, d.ResourceName, d.Layout)
 
 	if err != nil {
 		return fmt.Errorf("error parsing file (%s) sections: %w", d.path, err)
 	}
 
+	d.Suppressions = suppressionsWalker(d.document, d.source)
+
 	return nil
 }
 
+// detectLayout returns LayoutPluginDocs if the document has the level 2
+// Schema heading of terraform-plugin-docs, otherwise LayoutReference.
+func detectLayout(document ast.Node, source []byte) string {
+	for child := document.FirstChild(); child != nil; child = child.NextSibling() {
+		if heading, ok := child.(*ast.Heading); ok && heading.Level == 2 && string(heading.Text(source)) == "Schema" {
+			return LayoutPluginDocs
+		}
+	}
+
+	return LayoutReference
+}
+
+// diagnostic returns a finding of the rule positioned at the node, which may
+// be nil for findings about the whole document.
+func (d *Document) diagnostic(node ast.Node, rule string, format string, a ...any) *diagnostic.Diagnostic {
+	result := diagnostic.New(rule, format, a...)
+	result.Path = d.path
+
+	if node != nil {
+		result.Line, result.Column = markdown.NodePosition(node, d.source)
+	}
+
+	return result
+}
+
 func resourceName(providerName string, fileName string) string {
 	return providerName + "_" + fileName[:strings.IndexByte(fileName, '.')]
 }
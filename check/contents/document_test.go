@@ -6,6 +6,8 @@ package contents
 import (
 	"reflect"
 	"testing"
+
+	"github.com/YakDriver/tfproviderdocs/markdown"
 )
 
 func TestNewDocument(t *testing.T) {
@@ -74,3 +76,54 @@ func TestDocumentParse(t *testing.T) {
 		})
 	}
 }
+
+func TestDocumentParseSource(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Source      string
+		ExpectTitle string
+		ExpectLine  int
+	}{
+		{
+			Name: "frontmatter",
+			Source: `---
+subcategory: "Test"
+page_title: "Test: test_thing"
+---
+
+# Resource: test_thing
+`,
+			ExpectTitle: "Resource: test_thing",
+			ExpectLine:  6,
+		},
+		{
+			Name: "no frontmatter",
+			Source: `# Resource: test_thing
+`,
+			ExpectTitle: "Resource: test_thing",
+			ExpectLine:  1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			doc := NewDocument("docs/r/thing.md", "test")
+
+			if err := doc.ParseSource([]byte(testCase.Source)); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if doc.Sections.Title == nil {
+				t.Fatalf("expected title section, got none")
+			}
+
+			if got, want := string(doc.Sections.Title.Heading.Text(doc.source)), testCase.ExpectTitle; got != want {
+				t.Errorf("expected title %q, got %q", want, got)
+			}
+
+			if got, _ := markdown.NodePosition(doc.Sections.Title.Heading, doc.source); got != testCase.ExpectLine {
+				t.Errorf("expected title line %d, got %d", testCase.ExpectLine, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,52 @@
+// Copyright IBM Corp. 2019, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package contents
+
+import "github.com/YakDriver/tfproviderdocs/check/diagnostic"
+
+// Rule identifiers of contents check findings.
+const (
+	RuleArgumentsAnnotation = "arguments-annotation"
+	RuleArgumentsByline     = "arguments-byline"
+	RuleArgumentsOrdering   = "arguments-ordering"
+	RuleArgumentsRegion     = "arguments-region"
+	RuleArgumentsSchema     = "arguments-schema"
+	RuleArgumentsSection    = "arguments-section"
+	RuleAttributesByline    = "attributes-byline"
+	RuleAttributesOrdering  = "attributes-ordering"
+	RuleAttributesSchema    = "attributes-schema"
+	RuleAttributesSection   = "attributes-section"
+	RuleDeprecation         = "deprecation"
+	RuleExampleSchema       = "example-schema"
+	RuleExampleSection      = "example-section"
+	RuleHCLSyntax           = "hcl-syntax"
+	RuleImportSection       = "import-section"
+	RuleSignatureSection    = "signature-section"
+	RuleSuppression         = "suppression"
+	RuleTimeoutsSection     = "timeouts-section"
+	RuleTitleSection        = "title-section"
+)
+
+// Rules describes every rule of contents check findings.
+var Rules = []diagnostic.Rule{
+	{ID: RuleArgumentsAnnotation, Description: "Argument Required/Optional annotations match the provider schema."},
+	{ID: RuleArgumentsByline, Description: "Arguments section bylines use expected texts and list arguments accordingly."},
+	{ID: RuleArgumentsOrdering, Description: "Arguments section lists are sorted by name."},
+	{ID: RuleArgumentsRegion, Description: "Region-aware arguments sections contain an Optional region argument."},
+	{ID: RuleArgumentsSchema, Description: "Documented arguments match the configurable provider schema attributes."},
+	{ID: RuleArgumentsSection, Description: "Arguments section is present with the expected heading."},
+	{ID: RuleAttributesByline, Description: "Attributes section byline uses an expected text."},
+	{ID: RuleAttributesOrdering, Description: "Attributes section lists are sorted by name."},
+	{ID: RuleAttributesSchema, Description: "Documented attributes match the computed-only provider schema attributes."},
+	{ID: RuleAttributesSection, Description: "Attributes section is present or absent as expected with the expected heading."},
+	{ID: RuleDeprecation, Description: "Arguments, attributes, and resources deprecated in the provider schema, and only those, are documented as deprecated."},
+	{ID: RuleExampleSchema, Description: "Example configuration blocks only set provider schema arguments, including all required arguments and no computed-only attributes."},
+	{ID: RuleExampleSection, Description: "Example section is present with the expected heading and code blocks."},
+	{ID: RuleHCLSyntax, Description: "Terraform and HCL code blocks of the example and import sections are valid HCL native syntax."},
+	{ID: RuleImportSection, Description: "Import section is present or absent as expected with the expected wording and code blocks."},
+	{ID: RuleSignatureSection, Description: "Signature section is present or absent as expected with the expected heading."},
+	{ID: RuleSuppression, Description: "Suppression comment directives only list contents check rules."},
+	{ID: RuleTimeoutsSection, Description: "Timeouts section is present or absent as expected."},
+	{ID: RuleTitleSection, Description: "Title section is present with the expected heading."},
+}
@@ -4,6 +4,7 @@
 package contents
 
 import (
+	"strconv"
 	"strings"
 
 	"github.com/yuin/goldmark/ast"
@@ -14,18 +15,37 @@ import (
 // This may represent root or nested lists of arguments or attributes
 type SchemaAttributeList struct {
 	Items []*SchemaAttributeListItem
+
+	// List is the Markdown list of the documentation
+	List *ast.List
 }
 
 // SchemaAttributeListItem represents a schema attribute list item
 //
 // This may represent root or nested lists of arguments or attributes
 type SchemaAttributeListItem struct {
+	Computed    bool
+	Deprecated  bool
 	Description string
 	ForceNew    bool
+	MaxItems    uint64
+	MinItems    uint64
 	Name        string
 	Optional    bool
 	Required    bool
-	Type        string
+	Sensitive   bool
+	WriteOnly   bool
+
+	// Type is the documented type, such as String, List of String, or
+	// Block List
+	Type string
+
+	// ListItem is the Markdown list item of the documentation
+	ListItem *ast.ListItem
+
+	// Lists contains nested lists of the item, which document the
+	// arguments or attributes of a nested block
+	Lists []*SchemaAttributeList
 }
 
 type SchemaAttributeListItemByName []*SchemaAttributeListItem
@@ -34,8 +54,10 @@ func (item SchemaAttributeListItemByName) Len() int           { return len(item)
 func (item SchemaAttributeListItemByName) Swap(i, j int)      { item[i], item[j] = item[j], item[i] }
 func (item SchemaAttributeListItemByName) Less(i, j int) bool { return item[i].Name < item[j].Name }
 
-func schemaAttributeListWalker(list *ast.List, source []byte) (*SchemaAttributeList, error) {
-	result := &SchemaAttributeList{}
+func schemaAttributeListWalker(list *ast.List, source []byte, layout string) (*SchemaAttributeList, error) {
+	result := &SchemaAttributeList{
+		List: list,
+	}
 
 	err := ast.Walk(list, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
 		if !entering {
@@ -44,17 +66,38 @@ func schemaAttributeListWalker(list *ast.List, source []byte) (*SchemaAttributeL
 
 		switch node := node.(type) {
 		case *ast.ListItem:
-			item, err := schemaAttributeListItemWalker(node, source)
+			item, err := schemaAttributeListItemWalker(node, source, layout)
 
 			if err != nil {
 				return ast.WalkStop, err
 			}
 
-			if item.Name != "" {
-				result.Items = append(result.Items, item)
+			if item.Name == "" {
+				return ast.WalkSkipChildren, nil
 			}
 
-			return ast.WalkContinue, nil
+			for child := node.FirstChild(); child != nil; child = child.NextSibling() {
+				nestedList, ok := child.(*ast.List)
+
+				if !ok {
+					continue
+				}
+
+				schemaAttributeList, err := schemaAttributeListWalker(nestedList, source, layout)
+
+				if err != nil {
+					return ast.WalkStop, err
+				}
+
+				item.Lists = append(item.Lists, schemaAttributeList)
+			}
+
+			result.Items = append(result.Items, item)
+
+			// Nested lists document the attributes of a nested block rather
+			// than the attributes of this list, which are parsed into the
+			// item.
+			return ast.WalkSkipChildren, nil
 		}
 
 		return ast.WalkContinue, nil
@@ -63,10 +106,16 @@ func schemaAttributeListWalker(list *ast.List, source []byte) (*SchemaAttributeL
 	return result, err
 }
 
-func schemaAttributeListItemWalker(listItem *ast.ListItem, source []byte) (*SchemaAttributeListItem, error) {
-	result := &SchemaAttributeListItem{}
+func schemaAttributeListItemWalker(listItem *ast.ListItem, source []byte, layout string) (*SchemaAttributeListItem, error) {
+	result := &SchemaAttributeListItem{
+		ListItem: listItem,
+	}
 
-	// Expected format: `Name` - (Required/Optional[, ForceNew]) Description
+	// Expected format: `Name` - (Required/Optional[, Type][, Traits]) Description
+	// or with LayoutPluginDocs: `Name` (Type[, Traits]) Description
+	//
+	// Types include String, List of String, and Block List, and traits
+	// include Computed, Deprecated, Sensitive, Write-only, and Max: 1.
 
 	err := ast.Walk(listItem, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
 		if !entering {
@@ -78,6 +127,26 @@ func schemaAttributeListItemWalker(listItem *ast.ListItem, source []byte) (*Sche
 			text := string(node.Text(source))
 			itemParts := strings.SplitN(text, " - ", 2)
 
+			// terraform-plugin-docs separates the name code span from the
+			// traits with a space, so only items starting with a code span
+			// document an argument or attribute.
+			if layout == LayoutPluginDocs {
+				codeSpan, ok := node.FirstChild().(*ast.CodeSpan)
+
+				if !ok {
+					return ast.WalkStop, nil
+				}
+
+				name := string(codeSpan.Text(source))
+				fullDescription, ok := strings.CutPrefix(text, "`"+name+"` ")
+
+				if !ok {
+					return ast.WalkStop, nil
+				}
+
+				itemParts = []string{name, fullDescription}
+			}
+
 			if len(itemParts) != 2 {
 				return ast.WalkContinue, nil
 			}
@@ -93,20 +162,41 @@ func schemaAttributeListItemWalker(listItem *ast.ListItem, source []byte) (*Sche
 
 			traitsEndIndex := strings.IndexByte(fullDescription, ')')
 
+			// Without a closing parenthesis there are no traits to parse.
+			if traitsEndIndex < 0 {
+				result.Description = fullDescription
+
+				return ast.WalkStop, nil
+			}
+
 			result.Description = fullDescription[traitsEndIndex+1:]
 
 			traits := fullDescription[1:traitsEndIndex]
 
 			for trait := range strings.SplitSeq(traits, ", ") {
 				switch trait {
-				case "Boolean", "Number", "String":
-					result.Type = trait
+				case "Computed":
+					result.Computed = true
+				case "Deprecated":
+					result.Deprecated = true
 				case "Forces new", "Forces new resource":
 					result.ForceNew = true
 				case "Optional":
 					result.Optional = true
 				case "Required":
 					result.Required = true
+				case "Sensitive":
+					result.Sensitive = true
+				case "Write-only":
+					result.WriteOnly = true
+				default:
+					if limit, ok := strings.CutPrefix(trait, "Max: "); ok {
+						result.MaxItems, _ = strconv.ParseUint(limit, 10, 64)
+					} else if limit, ok := strings.CutPrefix(trait, "Min: "); ok {
+						result.MinItems, _ = strconv.ParseUint(limit, 10, 64)
+					} else if isSchemaTypeTrait(trait) {
+						result.Type = trait
+					}
 				}
 			}
 
@@ -118,3 +208,20 @@ func schemaAttributeListItemWalker(listItem *ast.ListItem, source []byte) (*Sche
 
 	return result, err
 }
+
+// isSchemaTypeTrait returns whether the trait is a documented type, such as
+// Number, Set of Object, Attributes List, or Block List.
+func isSchemaTypeTrait(trait string) bool {
+	switch trait {
+	case "Attributes", "Block", "Boolean", "Dynamic", "Number", "Object", "String":
+		return true
+	}
+
+	for _, prefix := range []string{"Attributes ", "Block ", "List of ", "Map of ", "Set of "} {
+		if strings.HasPrefix(trait, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,124 @@
+// Copyright IBM Corp. 2019, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package contents
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/YakDriver/tfproviderdocs/markdown"
+	"github.com/yuin/goldmark/ast"
+)
+
+func TestSchemaAttributeListItemWalker(t *testing.T) {
+	testCases := []struct {
+		Name   string
+		Layout string
+		Source string
+		Expect SchemaAttributeListItem
+	}{
+		{
+			Name:   "reference",
+			Source: "* `name` - (Required, String, Forces new resource) Name.\n",
+			Expect: SchemaAttributeListItem{
+				Description: " Name.",
+				ForceNew:    true,
+				Name:        "name",
+				Required:    true,
+				Type:        "String",
+			},
+		},
+		{
+			Name:   "unclosed traits",
+			Source: "* `name` - (Required Name.\n",
+			Expect: SchemaAttributeListItem{
+				Description: "(Required Name.",
+				Name:        "name",
+			},
+		},
+		{
+			Name:   "collection type",
+			Layout: LayoutPluginDocs,
+			Source: "- `tags` (Map of String, Computed) Tags.\n",
+			Expect: SchemaAttributeListItem{
+				Computed:    true,
+				Description: " Tags.",
+				Name:        "tags",
+				Type:        "Map of String",
+			},
+		},
+		{
+			Name:   "block limits",
+			Layout: LayoutPluginDocs,
+			Source: "- `rule` (Block List, Min: 1, Max: 2) Rule.\n",
+			Expect: SchemaAttributeListItem{
+				Description: " Rule.",
+				MaxItems:    2,
+				MinItems:    1,
+				Name:        "rule",
+				Type:        "Block List",
+			},
+		},
+		{
+			Name:   "traits",
+			Layout: LayoutPluginDocs,
+			Source: "- `password` (String, Sensitive, Deprecated, Write-only) Password.\n",
+			Expect: SchemaAttributeListItem{
+				Deprecated:  true,
+				Description: " Password.",
+				Name:        "password",
+				Sensitive:   true,
+				Type:        "String",
+				WriteOnly:   true,
+			},
+		},
+		{
+			Name:   "plugin docs unclosed traits",
+			Layout: LayoutPluginDocs,
+			Source: "- `name` (String Name.\n",
+			Expect: SchemaAttributeListItem{
+				Description: "(String Name.",
+				Name:        "name",
+			},
+		},
+		{
+			Name:   "plugin docs without code span",
+			Layout: LayoutPluginDocs,
+			Source: "- See (the nested schema) below.\n",
+		},
+		{
+			Name:   "nested type",
+			Layout: LayoutPluginDocs,
+			Source: "- `settings` (Attributes Set) Settings.\n",
+			Expect: SchemaAttributeListItem{
+				Description: " Settings.",
+				Name:        "settings",
+				Type:        "Attributes Set",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			source := []byte(testCase.Source)
+			list, ok := markdown.Parse(source).FirstChild().(*ast.List)
+
+			if !ok {
+				t.Fatalf("expected list")
+			}
+
+			got, err := schemaAttributeListItemWalker(list.FirstChild().(*ast.ListItem), source, testCase.Layout)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			got.ListItem = nil
+
+			if !reflect.DeepEqual(*got, testCase.Expect) {
+				t.Errorf("expected %#v, got %#v", testCase.Expect, *got)
+			}
+		})
+	}
+}
@@ -0,0 +1,170 @@
+// Copyright IBM Corp. 2019, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package contents
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-multierror"
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// checkSchemaType verifies that the documented type of the item, if any,
+// matches the type of the attribute or nested block of the same name in the
+// schema block. Items not found in the schema are left to the caller. The
+// subject (e.g. arguments section argument) prefixes finding messages.
+func (d *Document) checkSchemaType(item *SchemaAttributeListItem, block *tfjson.SchemaBlock, rule string, subject string, path string) error {
+	if item.Type == "" {
+		return nil
+	}
+
+	var schemaType string
+
+	if attribute, ok := block.Attributes[item.Name]; ok {
+		schemaType = schemaAttributeTypeName(attribute)
+	} else if blockType, ok := block.NestedBlocks[item.Name]; ok {
+		schemaType = schemaBlockTypeName(blockType)
+	}
+
+	if schemaType == "" || item.Type == schemaType {
+		return nil
+	}
+
+	diag := d.diagnostic(item.ListItem, rule, "%s (%s) type (%s) does not match schema type: %s", subject, path+item.Name, item.Type, schemaType)
+	diag.Suggestion = "document the type as " + schemaType
+
+	return diag
+}
+
+// schemaAttributeTypeName returns the type of the schema attribute as
+// documented by terraform-plugin-docs, such as List of String or Attributes
+// Set. It returns an empty string for types without a documented name.
+func schemaAttributeTypeName(attribute *tfjson.SchemaAttribute) string {
+	if nestedType := attribute.AttributeNestedType; nestedType != nil {
+		return nestingModeTypeName("Attributes", nestedType.NestingMode)
+	}
+
+	return ctyTypeName(attribute.AttributeType)
+}
+
+// schemaBlockTypeName returns the type of the nested block as documented by
+// terraform-plugin-docs, such as Block List.
+func schemaBlockTypeName(blockType *tfjson.SchemaBlockType) string {
+	return nestingModeTypeName("Block", blockType.NestingMode)
+}
+
+func nestingModeTypeName(kind string, nestingMode tfjson.SchemaNestingMode) string {
+	switch nestingMode {
+	case tfjson.SchemaNestingModeList:
+		return kind + " List"
+	case tfjson.SchemaNestingModeMap:
+		return kind + " Map"
+	case tfjson.SchemaNestingModeSet:
+		return kind + " Set"
+	case tfjson.SchemaNestingModeGroup, tfjson.SchemaNestingModeSingle:
+		return kind
+	}
+
+	return ""
+}
+
+func ctyTypeName(ty cty.Type) string {
+	switch {
+	case ty == cty.NilType:
+		return ""
+	case ty.Equals(cty.Bool):
+		return "Boolean"
+	case ty.Equals(cty.DynamicPseudoType):
+		return "Dynamic"
+	case ty.Equals(cty.Number):
+		return "Number"
+	case ty.Equals(cty.String):
+		return "String"
+	case ty.IsObjectType():
+		return "Object"
+	case ty.IsListType(), ty.IsMapType(), ty.IsSetType():
+		elementTypeName := ctyTypeName(ty.ElementType())
+
+		if elementTypeName == "" {
+			return ""
+		}
+
+		switch {
+		case ty.IsListType():
+			return "List of " + elementTypeName
+		case ty.IsMapType():
+			return "Map of " + elementTypeName
+		default:
+			return "Set of " + elementTypeName
+		}
+	}
+
+	return ""
+}
+
+// checkSchemaTraits verifies that the documented Sensitive, Write-only, Max,
+// and Min traits of the item match the attribute or nested block of the same
+// name in the schema block. Schema traits missing from the documentation are
+// only reported for items documenting their type, since terraform-plugin-docs
+// lists every trait alongside the type. Items not found in the schema are left
+// to the caller. The subject (e.g. arguments section argument) prefixes
+// finding messages.
+func (d *Document) checkSchemaTraits(item *SchemaAttributeListItem, block *tfjson.SchemaBlock, rule string, subject string, path string) error {
+	var documentedTraits, schemaTraits []string
+
+	if attribute, ok := block.Attributes[item.Name]; ok {
+		documentedTraits = []string{flagTrait("Sensitive", item.Sensitive), flagTrait("Write-only", item.WriteOnly)}
+		schemaTraits = []string{flagTrait("Sensitive", attribute.Sensitive), flagTrait("Write-only", attribute.WriteOnly)}
+	} else if blockType, ok := block.NestedBlocks[item.Name]; ok {
+		documentedTraits = []string{limitTrait("Max", item.MaxItems), limitTrait("Min", item.MinItems)}
+		schemaTraits = []string{limitTrait("Max", blockType.MaxItems), limitTrait("Min", blockType.MinItems)}
+	}
+
+	var result *multierror.Error
+
+	for i, documentedTrait := range documentedTraits {
+		schemaTrait := schemaTraits[i]
+
+		if documentedTrait == schemaTrait {
+			continue
+		}
+
+		switch {
+		case schemaTrait == "":
+			diag := d.diagnostic(item.ListItem, rule, "%s (%s) trait (%s) is not in schema", subject, path+item.Name, documentedTrait)
+			diag.Suggestion = "remove the trait " + documentedTrait
+			result = multierror.Append(result, diag)
+		case documentedTrait == "" && item.Type != "":
+			diag := d.diagnostic(item.ListItem, rule, "%s (%s) missing schema trait: %s", subject, path+item.Name, schemaTrait)
+			diag.Suggestion = "document the trait " + schemaTrait
+			result = multierror.Append(result, diag)
+		case documentedTrait != "":
+			diag := d.diagnostic(item.ListItem, rule, "%s (%s) trait (%s) does not match schema trait: %s", subject, path+item.Name, documentedTrait, schemaTrait)
+			diag.Suggestion = "document the trait as " + schemaTrait
+			result = multierror.Append(result, diag)
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+// flagTrait returns the trait if it is set, otherwise an empty string.
+func flagTrait(trait string, set bool) string {
+	if !set {
+		return ""
+	}
+
+	return trait
+}
+
+// limitTrait returns the trait with the limit, such as Max: 1, if the limit
+// is set, otherwise an empty string.
+func limitTrait(trait string, limit uint64) string {
+	if limit == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf("%s: %d", trait, limit)
+}
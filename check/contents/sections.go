@@ -18,6 +18,7 @@ const (
 	walkerSectionAttributes
 	walkerSectionTimeouts
 	walkerSectionImport
+	walkerSectionSchema
 )
 
 // Sections represents all expected sections of a resource documentation page
@@ -74,6 +75,11 @@ type SchemaAttributeSection struct {
 	// Heading is the root/nested heading for the section
 	Heading *ast.Heading
 
+	// Name is the nested block name of a nested section, which is the first
+	// code span of the heading (e.g. rule of "### `rule` Configuration Block")
+	// or otherwise the first word of the heading
+	Name string
+
 	// Lists is the groupings of per-attribute documentation
 	//
 	// Some sections may be split these based on Optional versus Required
@@ -105,11 +111,18 @@ type TitleSection struct {
 	Paragraphs       []*ast.Paragraph
 }
 
-func sectionsWalker(document ast.Node, source []byte, resourceName string) (*Sections, error) {
+func sectionsWalker(document ast.Node, source []byte, resourceName string, layout string) (*Sections, error) {
 	result := &Sections{}
 
 	var walkerSectionStartingLevel, walkerSection int
 
+	// walkerSchemaAttributeSections is the stack of the arguments or
+	// attributes section and its nested sections, innermost last.
+	var walkerSchemaAttributeSections []*SchemaAttributeSection
+
+	// walkerSchema tracks the sections of the LayoutPluginDocs Schema section.
+	var walkerSchema *pluginDocsSchema
+
 	err := ast.Walk(document, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
 		if !entering {
 			return ast.WalkContinue, nil
@@ -124,14 +137,17 @@ func sectionsWalker(document ast.Node, source []byte, resourceName string) (*Sec
 				result.Signature.FencedCodeBlocks = append(result.Signature.FencedCodeBlocks, node)
 			case walkerSectionExample:
 				result.Example.FencedCodeBlocks = append(result.Example.FencedCodeBlocks, node)
-			case walkerSectionArguments:
-				result.Arguments.FencedCodeBlocks = append(result.Arguments.FencedCodeBlocks, node)
-			case walkerSectionAttributes:
-				result.Attributes.FencedCodeBlocks = append(result.Attributes.FencedCodeBlocks, node)
+			case walkerSectionArguments, walkerSectionAttributes:
+				section := walkerSchemaAttributeSections[len(walkerSchemaAttributeSections)-1]
+				section.FencedCodeBlocks = append(section.FencedCodeBlocks, node)
 			case walkerSectionTimeouts:
 				result.Timeouts.FencedCodeBlocks = append(result.Timeouts.FencedCodeBlocks, node)
 			case walkerSectionImport:
 				result.Import.FencedCodeBlocks = append(result.Import.FencedCodeBlocks, node)
+			case walkerSectionSchema:
+				if walkerSchema.target != nil {
+					walkerSchema.target.FencedCodeBlocks = append(walkerSchema.target.FencedCodeBlocks, node)
+				}
 			}
 
 			return ast.WalkSkipChildren, nil
@@ -144,6 +160,26 @@ func sectionsWalker(document ast.Node, source []byte, resourceName string) (*Sec
 				walkerSection = walkerSectionUnknown
 			}
 
+			if layout == LayoutPluginDocs {
+				if result.Arguments == nil && node.Level == 2 && headingText == "Schema" {
+					result.Arguments = &ArgumentsSection{
+						Heading: node,
+					}
+
+					walkerSection = walkerSectionSchema
+					walkerSectionStartingLevel = node.Level
+					walkerSchema = newPluginDocsSchema(result)
+
+					return ast.WalkSkipChildren, nil
+				}
+
+				if walkerSection == walkerSectionSchema && node.Level > walkerSectionStartingLevel {
+					walkerSchema.walkHeading(node, source)
+
+					return ast.WalkSkipChildren, nil
+				}
+			}
+
 			if result.Title == nil {
 				foundResourceName := false
 				if resourceName != "" {
@@ -201,6 +237,7 @@ func sectionsWalker(document ast.Node, source []byte, resourceName string) (*Sec
 
 				walkerSection = walkerSectionArguments
 				walkerSectionStartingLevel = node.Level
+				walkerSchemaAttributeSections = []*SchemaAttributeSection{(*SchemaAttributeSection)(result.Arguments)}
 
 				return ast.WalkContinue, nil
 			}
@@ -212,6 +249,7 @@ func sectionsWalker(document ast.Node, source []byte, resourceName string) (*Sec
 
 				walkerSection = walkerSectionAttributes
 				walkerSectionStartingLevel = node.Level
+				walkerSchemaAttributeSections = []*SchemaAttributeSection{(*SchemaAttributeSection)(result.Attributes)}
 
 				return ast.WalkContinue, nil
 			}
@@ -238,32 +276,46 @@ func sectionsWalker(document ast.Node, source []byte, resourceName string) (*Sec
 				return ast.WalkContinue, nil
 			}
 
+			// Deeper headings of the arguments and attributes sections document
+			// nested blocks.
+			if (walkerSection == walkerSectionArguments || walkerSection == walkerSectionAttributes) && node.Level > walkerSectionStartingLevel {
+				for len(walkerSchemaAttributeSections) > 1 && walkerSchemaAttributeSections[len(walkerSchemaAttributeSections)-1].Heading.Level >= node.Level {
+					walkerSchemaAttributeSections = walkerSchemaAttributeSections[:len(walkerSchemaAttributeSections)-1]
+				}
+
+				parent := walkerSchemaAttributeSections[len(walkerSchemaAttributeSections)-1]
+				child := &SchemaAttributeSection{
+					Heading: node,
+					Name:    headingName(node, source),
+				}
+
+				parent.Children = append(parent.Children, child)
+				walkerSchemaAttributeSections = append(walkerSchemaAttributeSections, child)
+
+				return ast.WalkSkipChildren, nil
+			}
+
 			//fmt.Printf("(walker section level: %d) unknown heading level %d: %s\n", walkerSectionStartingLevel, node.Level, headingText)
 			walkerSection = walkerSectionUnknown
 
 			return ast.WalkSkipChildren, nil
 		case *ast.List:
 			switch walkerSection {
-			case walkerSectionArguments:
-				result.Arguments.Lists = append(result.Arguments.Lists, node)
+			case walkerSectionArguments, walkerSectionAttributes:
+				section := walkerSchemaAttributeSections[len(walkerSchemaAttributeSections)-1]
+				section.Lists = append(section.Lists, node)
 
-				schemaAttributeList, err := schemaAttributeListWalker(node, source)
+				schemaAttributeList, err := schemaAttributeListWalker(node, source, layout)
 
 				if err != nil {
 					return ast.WalkStop, err
 				}
 
-				result.Arguments.SchemaAttributeLists = append(result.Arguments.SchemaAttributeLists, schemaAttributeList)
-			case walkerSectionAttributes:
-				result.Attributes.Lists = append(result.Attributes.Lists, node)
-
-				schemaAttributeList, err := schemaAttributeListWalker(node, source)
-
-				if err != nil {
+				section.SchemaAttributeLists = append(section.SchemaAttributeLists, schemaAttributeList)
+			case walkerSectionSchema:
+				if err := walkerSchema.walkList(node, source); err != nil {
 					return ast.WalkStop, err
 				}
-
-				result.Attributes.SchemaAttributeLists = append(result.Attributes.SchemaAttributeLists, schemaAttributeList)
 			case walkerSectionTimeouts:
 				result.Timeouts.Lists = append(result.Timeouts.Lists, node)
 			}
@@ -277,14 +329,15 @@ func sectionsWalker(document ast.Node, source []byte, resourceName string) (*Sec
 				result.Signature.Paragraphs = append(result.Signature.Paragraphs, node)
 			case walkerSectionExample:
 				result.Example.Paragraphs = append(result.Example.Paragraphs, node)
-			case walkerSectionArguments:
-				result.Arguments.Paragraphs = append(result.Arguments.Paragraphs, node)
-			case walkerSectionAttributes:
-				result.Attributes.Paragraphs = append(result.Attributes.Paragraphs, node)
+			case walkerSectionArguments, walkerSectionAttributes:
+				section := walkerSchemaAttributeSections[len(walkerSchemaAttributeSections)-1]
+				section.Paragraphs = append(section.Paragraphs, node)
 			case walkerSectionTimeouts:
 				result.Timeouts.Paragraphs = append(result.Timeouts.Paragraphs, node)
 			case walkerSectionImport:
 				result.Import.Paragraphs = append(result.Import.Paragraphs, node)
+			case walkerSectionSchema:
+				walkerSchema.walkParagraph(node, source)
 			}
 
 			return ast.WalkSkipChildren, nil
@@ -295,3 +348,157 @@ func sectionsWalker(document ast.Node, source []byte, resourceName string) (*Sec
 
 	return result, err
 }
+
+// headingName returns the text of the first code span of the heading, or
+// otherwise the first word of the heading text.
+func headingName(heading *ast.Heading, source []byte) string {
+	for child := heading.FirstChild(); child != nil; child = child.NextSibling() {
+		if codeSpan, ok := child.(*ast.CodeSpan); ok {
+			return string(codeSpan.Text(source))
+		}
+	}
+
+	if fields := strings.Fields(string(heading.Text(source))); len(fields) > 0 {
+		return fields[0]
+	}
+
+	return ""
+}
+
+// pluginDocsSchema tracks the sections of the terraform-plugin-docs Schema
+// section while it is walked. Required and Optional lists are added to the
+// arguments section, with items annotated accordingly, and Read-Only lists to
+// the attributes section. Each Nested Schema heading starts nested sections,
+// named by the dotted path of the nested block (e.g. rule.filter), in which
+// Required:, Optional:, and Read-Only: paragraphs introduce the lists.
+type pluginDocsSchema struct {
+	// annotation is Required or Optional for arguments lists
+	annotation string
+
+	// arguments and attributes are the root or nested sections of the
+	// current heading, attributes being created once Read-Only is found
+	arguments  *SchemaAttributeSection
+	attributes *SchemaAttributeSection
+
+	// nestedHeading is the current Nested Schema heading, if any
+	nestedHeading *ast.Heading
+
+	sections *Sections
+
+	// target is the section receiving lists and paragraphs, if any
+	target *SchemaAttributeSection
+}
+
+func newPluginDocsSchema(sections *Sections) *pluginDocsSchema {
+	arguments := (*SchemaAttributeSection)(sections.Arguments)
+
+	return &pluginDocsSchema{
+		arguments: arguments,
+		sections:  sections,
+		target:    arguments,
+	}
+}
+
+func (s *pluginDocsSchema) walkHeading(heading *ast.Heading, source []byte) {
+	headingText := string(heading.Text(source))
+
+	switch {
+	case headingText == "Required" || headingText == "Optional" || headingText == "Read-Only":
+		s.arguments = (*SchemaAttributeSection)(s.sections.Arguments)
+		s.attributes = (*SchemaAttributeSection)(s.sections.Attributes)
+		s.nestedHeading = nil
+
+		if headingText == "Read-Only" && s.attributes == nil {
+			s.sections.Attributes = &AttributesSection{
+				Heading: heading,
+			}
+			s.attributes = (*SchemaAttributeSection)(s.sections.Attributes)
+		}
+
+		s.setTarget(headingText)
+	case strings.HasPrefix(headingText, "Nested Schema for"):
+		s.arguments = &SchemaAttributeSection{
+			Heading: heading,
+			Name:    headingName(heading, source),
+		}
+		s.attributes = nil
+		s.nestedHeading = heading
+		s.sections.Arguments.Children = append(s.sections.Arguments.Children, s.arguments)
+		s.target = nil
+	default:
+		s.target = nil
+	}
+}
+
+func (s *pluginDocsSchema) walkList(list *ast.List, source []byte) error {
+	if s.target == nil {
+		return nil
+	}
+
+	schemaAttributeList, err := schemaAttributeListWalker(list, source, LayoutPluginDocs)
+
+	if err != nil {
+		return err
+	}
+
+	for _, item := range schemaAttributeList.Items {
+		switch s.annotation {
+		case "Optional":
+			item.Optional = true
+		case "Required":
+			item.Required = true
+		}
+	}
+
+	s.target.Lists = append(s.target.Lists, list)
+	s.target.SchemaAttributeLists = append(s.target.SchemaAttributeLists, schemaAttributeList)
+
+	return nil
+}
+
+func (s *pluginDocsSchema) walkParagraph(paragraph *ast.Paragraph, source []byte) {
+	paragraphText := strings.TrimSpace(string(paragraph.Text(source)))
+
+	// Paragraphs of only HTML, such as nested schema anchors, have no text.
+	if paragraphText == "" {
+		return
+	}
+
+	if s.nestedHeading != nil {
+		if kind, ok := strings.CutSuffix(paragraphText, ":"); ok && (kind == "Required" || kind == "Optional" || kind == "Read-Only") {
+			if kind == "Read-Only" && s.attributes == nil {
+				if s.sections.Attributes == nil {
+					s.sections.Attributes = &AttributesSection{
+						Heading: s.sections.Arguments.Heading,
+					}
+				}
+
+				s.attributes = &SchemaAttributeSection{
+					Heading: s.nestedHeading,
+					Name:    s.arguments.Name,
+				}
+				s.sections.Attributes.Children = append(s.sections.Attributes.Children, s.attributes)
+			}
+
+			s.setTarget(kind)
+		}
+	}
+
+	if s.target != nil {
+		s.target.Paragraphs = append(s.target.Paragraphs, paragraph)
+	}
+}
+
+// setTarget directs following lists to the arguments section for Required or
+// Optional, or to the attributes section for Read-Only.
+func (s *pluginDocsSchema) setTarget(kind string) {
+	s.annotation = ""
+	s.target = s.arguments
+
+	switch kind {
+	case "Optional", "Required":
+		s.annotation = kind
+	case "Read-Only":
+		s.target = s.attributes
+	}
+}
@@ -0,0 +1,221 @@
+// Copyright IBM Corp. 2019, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package contents
+
+import (
+	"bytes"
+	"slices"
+	"strings"
+
+	"github.com/YakDriver/tfproviderdocs/check/diagnostic"
+	"github.com/YakDriver/tfproviderdocs/markdown"
+	"github.com/hashicorp/go-multierror"
+	"github.com/yuin/goldmark/ast"
+)
+
+const (
+	// DirectiveIgnore suppresses findings of the listed rules in the whole
+	// document, e.g. <!-- tfproviderdocs:ignore arguments-byline -->
+	DirectiveIgnore = "ignore"
+
+	// DirectiveIgnoreNextSection suppresses findings of the listed rules in
+	// the section following the comment, up to the next heading of the same or
+	// higher level, e.g. <!-- tfproviderdocs:ignore-next-section import-section -->
+	DirectiveIgnoreNextSection = "ignore-next-section"
+
+	directivePrefix = "tfproviderdocs:"
+)
+
+// Suppression represents an inline suppression comment directive.
+type Suppression struct {
+	// EndLine is the last suppressed line, or 0 for the whole document.
+	EndLine int
+
+	// Node is the comment directive of the suppression.
+	Node ast.Node
+
+	// Rules contains the suppressed rule identifiers. All rules are
+	// suppressed when empty.
+	Rules []string
+
+	// StartLine is the first suppressed line, or 0 for the whole document.
+	StartLine int
+}
+
+// Suppresses returns true if the diagnostic is suppressed. Findings without a
+// line can only be suppressed in the whole document.
+func (s *Suppression) Suppresses(d *diagnostic.Diagnostic) bool {
+	if len(s.Rules) > 0 && !slices.Contains(s.Rules, d.Rule) {
+		return false
+	}
+
+	if s.StartLine == 0 {
+		return true
+	}
+
+	return d.Line >= s.StartLine && (s.EndLine == 0 || d.Line <= s.EndLine)
+}
+
+// suppressionsWalker returns the suppressions of the document comment
+// directives.
+func suppressionsWalker(document ast.Node, source []byte) []*Suppression {
+	var result []*Suppression
+
+	for node := document.FirstChild(); node != nil; node = node.NextSibling() {
+		htmlBlock, ok := node.(*ast.HTMLBlock)
+
+		if !ok || htmlBlock.HTMLBlockType != ast.HTMLBlockType2 {
+			continue
+		}
+
+		directive, rules, ok := parseSuppressionDirective(htmlBlockText(htmlBlock, source))
+
+		if !ok {
+			continue
+		}
+
+		switch directive {
+		case DirectiveIgnore:
+			result = append(result, &Suppression{Node: htmlBlock, Rules: rules})
+		case DirectiveIgnoreNextSection:
+			if suppression := nextSectionSuppression(htmlBlock, source, rules); suppression != nil {
+				result = append(result, suppression)
+			}
+		}
+	}
+
+	return result
+}
+
+// nextSectionSuppression returns a suppression of the lines from the first
+// heading following the node up to the next heading of the same or higher
+// level, or nil if no heading follows.
+func nextSectionSuppression(node ast.Node, source []byte, rules []string) *Suppression {
+	var heading *ast.Heading
+
+	for next := node.NextSibling(); next != nil; next = next.NextSibling() {
+		if next, ok := next.(*ast.Heading); ok {
+			heading = next
+			break
+		}
+	}
+
+	if heading == nil {
+		return nil
+	}
+
+	result := &Suppression{
+		Node:  node,
+		Rules: rules,
+	}
+
+	result.StartLine, _ = markdown.NodePosition(heading, source)
+
+	for next := heading.NextSibling(); next != nil; next = next.NextSibling() {
+		if next, ok := next.(*ast.Heading); ok && next.Level <= heading.Level {
+			line, _ := markdown.NodePosition(next, source)
+			result.EndLine = line - 1
+
+			break
+		}
+	}
+
+	return result
+}
+
+// parseSuppressionDirective returns the directive and rules of an HTML comment,
+// e.g. <!-- tfproviderdocs:ignore rule-one rule-two -->
+func parseSuppressionDirective(text string) (string, []string, bool) {
+	text = strings.TrimSpace(text)
+
+	if !strings.HasPrefix(text, "<!--") || !strings.HasSuffix(text, "-->") {
+		return "", nil, false
+	}
+
+	fields := strings.Fields(strings.TrimSuffix(strings.TrimPrefix(text, "<!--"), "-->"))
+
+	if len(fields) == 0 || !strings.HasPrefix(fields[0], directivePrefix) {
+		return "", nil, false
+	}
+
+	directive := strings.TrimPrefix(fields[0], directivePrefix)
+
+	if directive != DirectiveIgnore && directive != DirectiveIgnoreNextSection {
+		return "", nil, false
+	}
+
+	// Allow comma separated rules in addition to whitespace separated rules.
+	var rules []string
+
+	for _, field := range fields[1:] {
+		for rule := range strings.SplitSeq(field, ",") {
+			if rule != "" {
+				rules = append(rules, rule)
+			}
+		}
+	}
+
+	return directive, rules, true
+}
+
+func htmlBlockText(node *ast.HTMLBlock, source []byte) string {
+	var buf bytes.Buffer
+
+	for i := 0; i < node.Lines().Len(); i++ {
+		segment := node.Lines().At(i)
+		buf.Write(segment.Value(source))
+	}
+
+	if node.HasClosure() {
+		buf.Write(node.ClosureLine.Value(source))
+	}
+
+	return buf.String()
+}
+
+// checkSuppressions verifies that the comment directives only list contents
+// check rules. Other rules, such as frontmatter rules, are not reported by the
+// contents check and cannot be suppressed.
+func (d *Document) checkSuppressions() error {
+	var result *multierror.Error
+
+	for _, suppression := range d.Suppressions {
+		for _, rule := range suppression.Rules {
+			if slices.ContainsFunc(Rules, func(r diagnostic.Rule) bool { return r.ID == rule }) {
+				continue
+			}
+
+			diag := d.diagnostic(suppression.Node, RuleSuppression, "suppression directive rule (%s) is not a contents check rule", rule)
+			diag.Suggestion = "list only contents check rules, such as " + RuleArgumentsByline
+
+			result = multierror.Append(result, diag)
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+// suppress returns err without the diagnostics suppressed by the document
+// comment directives.
+func (d *Document) suppress(err error) error {
+	if err == nil || len(d.Suppressions) == 0 {
+		return err
+	}
+
+	var result []error
+
+	for _, diag := range diagnostic.FromError(err) {
+		if slices.ContainsFunc(d.Suppressions, func(s *Suppression) bool { return s.Suppresses(diag) }) {
+			continue
+		}
+
+		result = append(result, diag)
+	}
+
+	if len(result) == 0 {
+		return nil
+	}
+
+	return multierror.Append(nil, result...)
+}
@@ -0,0 +1,183 @@
+// Copyright IBM Corp. 2019, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package contents
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/YakDriver/tfproviderdocs/check/diagnostic"
+)
+
+func TestParseSuppressionDirective(t *testing.T) {
+	testCases := []struct {
+		Name            string
+		Text            string
+		ExpectDirective string
+		ExpectRules     []string
+		ExpectOk        bool
+	}{
+		{
+			Name: "not a comment",
+			Text: "<div>tfproviderdocs:ignore</div>",
+		},
+		{
+			Name: "other comment",
+			Text: "<!-- SPDX-License-Identifier: MPL-2.0 -->",
+		},
+		{
+			Name: "unknown directive",
+			Text: "<!-- tfproviderdocs:skip arguments-byline -->",
+		},
+		{
+			Name:            "ignore all",
+			Text:            "<!-- tfproviderdocs:ignore -->",
+			ExpectDirective: DirectiveIgnore,
+			ExpectOk:        true,
+		},
+		{
+			Name:            "ignore rules",
+			Text:            "<!-- tfproviderdocs:ignore arguments-byline import-section,title-section -->\n",
+			ExpectDirective: DirectiveIgnore,
+			ExpectRules:     []string{"arguments-byline", "import-section", "title-section"},
+			ExpectOk:        true,
+		},
+		{
+			Name:            "ignore next section",
+			Text:            "<!--\ntfproviderdocs:ignore-next-section import-section\n-->",
+			ExpectDirective: DirectiveIgnoreNextSection,
+			ExpectRules:     []string{"import-section"},
+			ExpectOk:        true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			directive, rules, ok := parseSuppressionDirective(testCase.Text)
+
+			if ok != testCase.ExpectOk {
+				t.Fatalf("expected ok %t, got %t", testCase.ExpectOk, ok)
+			}
+
+			if directive != testCase.ExpectDirective {
+				t.Errorf("expected directive %q, got %q", testCase.ExpectDirective, directive)
+			}
+
+			if !slices.Equal(rules, testCase.ExpectRules) {
+				t.Errorf("expected rules %v, got %v", testCase.ExpectRules, rules)
+			}
+		})
+	}
+}
+
+func TestSuppressionSuppresses(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Suppression *Suppression
+		Diagnostic  *diagnostic.Diagnostic
+		Expect      bool
+	}{
+		{
+			Name:        "document all rules",
+			Suppression: &Suppression{},
+			Diagnostic:  &diagnostic.Diagnostic{Rule: RuleTitleSection},
+			Expect:      true,
+		},
+		{
+			Name:        "document matching rule",
+			Suppression: &Suppression{Rules: []string{RuleTitleSection}},
+			Diagnostic:  &diagnostic.Diagnostic{Line: 10, Rule: RuleTitleSection},
+			Expect:      true,
+		},
+		{
+			Name:        "document other rule",
+			Suppression: &Suppression{Rules: []string{RuleTitleSection}},
+			Diagnostic:  &diagnostic.Diagnostic{Line: 10, Rule: RuleImportSection},
+		},
+		{
+			Name:        "section inside",
+			Suppression: &Suppression{EndLine: 20, Rules: []string{RuleImportSection}, StartLine: 10},
+			Diagnostic:  &diagnostic.Diagnostic{Line: 15, Rule: RuleImportSection},
+			Expect:      true,
+		},
+		{
+			Name:        "section outside",
+			Suppression: &Suppression{EndLine: 20, Rules: []string{RuleImportSection}, StartLine: 10},
+			Diagnostic:  &diagnostic.Diagnostic{Line: 21, Rule: RuleImportSection},
+		},
+		{
+			Name:        "section to end of document",
+			Suppression: &Suppression{Rules: []string{RuleImportSection}, StartLine: 10},
+			Diagnostic:  &diagnostic.Diagnostic{Line: 100, Rule: RuleImportSection},
+			Expect:      true,
+		},
+		{
+			Name:        "section without line",
+			Suppression: &Suppression{Rules: []string{RuleImportSection}, StartLine: 10},
+			Diagnostic:  &diagnostic.Diagnostic{Rule: RuleImportSection},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			if got := testCase.Suppression.Suppresses(testCase.Diagnostic); got != testCase.Expect {
+				t.Errorf("expected %t, got %t", testCase.Expect, got)
+			}
+		})
+	}
+}
+
+func TestDocumentCheckSuppressions(t *testing.T) {
+	doc := NewDocument("testdata/suppressions.md", "test")
+
+	if err := doc.Parse(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(doc.Suppressions) != 2 {
+		t.Fatalf("expected 2 suppressions, got %d", len(doc.Suppressions))
+	}
+
+	var got []string
+
+	for _, d := range diagnostic.FromError(doc.Check(nil)) {
+		got = append(got, d.Rule)
+	}
+
+	if expected := []string{RuleExampleSection}; !slices.Equal(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+}
+
+func TestDocumentCheckSuppressionsUnknownRule(t *testing.T) {
+	doc := NewDocument("testdata/suppressions_unknown.md", "test")
+	doc.ResourceName = "test_suppressions"
+
+	if err := doc.Parse(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var got []string
+
+	for _, d := range diagnostic.FromError(doc.Check(nil)) {
+		got = append(got, fmt.Sprintf("%d:%d: %s", d.Line, d.Column, d.Rule))
+
+		if d.Rule == RuleSuppression {
+			if expected := "suppression directive rule (argument-byline) is not a contents check rule"; d.Message != expected {
+				t.Errorf("expected message %q, got %q", expected, d.Message)
+			}
+		}
+	}
+
+	expected := []string{
+		"16:1: " + RuleExampleSection,
+		"26:1: " + RuleArgumentsByline,
+		"10:1: " + RuleSuppression,
+	}
+
+	if !slices.Equal(got, expected) {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+}
@@ -0,0 +1,184 @@
+// Copyright IBM Corp. 2019, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package diagnostic
+
+import (
+	"cmp"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+var fingerprintNumberRegexp = regexp.MustCompile(`[0-9]+`)
+
+// Baseline represents previously accepted findings, so only new findings are
+// reported.
+type Baseline struct {
+	Entries []*BaselineEntry `json:"entries"`
+}
+
+// BaselineEntry represents accepted findings of a rule in a file with the
+// same message fingerprint.
+type BaselineEntry struct {
+	// Count is the number of accepted findings.
+	Count int `json:"count"`
+
+	// Fingerprint is the hash of the normalized finding message.
+	Fingerprint string `json:"fingerprint"`
+
+	// Message is the finding message, for reference only.
+	Message string `json:"message"`
+
+	Path string `json:"path"`
+	Rule string `json:"rule"`
+}
+
+type baselineKey struct {
+	fingerprint string
+	path        string
+	rule        string
+}
+
+func (e *BaselineEntry) key() baselineKey {
+	return baselineKey{
+		fingerprint: e.Fingerprint,
+		path:        e.Path,
+		rule:        e.Rule,
+	}
+}
+
+// NewBaseline returns a Baseline accepting all the diagnostics.
+func NewBaseline(diagnostics []*Diagnostic) *Baseline {
+	entries := make(map[baselineKey]*BaselineEntry)
+
+	for _, d := range diagnostics {
+		entry := newBaselineEntry(d)
+
+		if existing, ok := entries[entry.key()]; ok {
+			existing.Count++
+			continue
+		}
+
+		entries[entry.key()] = entry
+	}
+
+	result := &Baseline{
+		Entries: make([]*BaselineEntry, 0, len(entries)),
+	}
+
+	for _, entry := range entries {
+		result.Entries = append(result.Entries, entry)
+	}
+
+	slices.SortFunc(result.Entries, func(a, b *BaselineEntry) int {
+		return cmp.Or(
+			cmp.Compare(a.Path, b.Path),
+			cmp.Compare(a.Rule, b.Rule),
+			cmp.Compare(a.Fingerprint, b.Fingerprint),
+		)
+	})
+
+	return result
+}
+
+// ReadBaseline reads a Baseline from a JSON file.
+func ReadBaseline(path string) (*Baseline, error) {
+	content, err := os.ReadFile(path)
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading baseline file (%s): %w", path, err)
+	}
+
+	var result Baseline
+
+	if err := json.Unmarshal(content, &result); err != nil {
+		return nil, fmt.Errorf("error parsing baseline file (%s): %w", path, err)
+	}
+
+	return &result, nil
+}
+
+// WriteFile writes the Baseline as a JSON file.
+func (b *Baseline) WriteFile(path string) error {
+	content, err := json.MarshalIndent(b, "", "  ")
+
+	if err != nil {
+		return fmt.Errorf("error encoding baseline: %w", err)
+	}
+
+	if err := os.WriteFile(path, append(content, '\n'), 0644); err != nil {
+		return fmt.Errorf("error writing baseline file (%s): %w", path, err)
+	}
+
+	return nil
+}
+
+// Filter returns the diagnostics not accepted by the Baseline and the
+// Baseline entries which no longer occur. Entries which occur fewer times
+// than their count are returned with the remaining count.
+func (b *Baseline) Filter(diagnostics []*Diagnostic) ([]*Diagnostic, []*BaselineEntry) {
+	remaining := make(map[baselineKey]int)
+
+	for _, entry := range b.Entries {
+		remaining[entry.key()] += entry.Count
+	}
+
+	var result []*Diagnostic
+
+	for _, d := range diagnostics {
+		key := newBaselineEntry(d).key()
+
+		if remaining[key] > 0 {
+			remaining[key]--
+			continue
+		}
+
+		result = append(result, d)
+	}
+
+	var stale []*BaselineEntry
+
+	for _, entry := range b.Entries {
+		key := entry.key()
+
+		if remaining[key] <= 0 {
+			continue
+		}
+
+		staleEntry := *entry
+		staleEntry.Count = min(entry.Count, remaining[key])
+		remaining[key] -= staleEntry.Count
+
+		stale = append(stale, &staleEntry)
+	}
+
+	return result, stale
+}
+
+// Fingerprint returns the hash of the normalized diagnostic message. Numbers
+// are normalized so findings, such as file sizes, remain accepted as they
+// change.
+func Fingerprint(d *Diagnostic) string {
+	normalized := strings.Join(strings.Fields(strings.ToLower(d.Message)), " ")
+	normalized = fingerprintNumberRegexp.ReplaceAllString(normalized, "0")
+	sum := sha256.Sum256([]byte(normalized))
+
+	return hex.EncodeToString(sum[:8])
+}
+
+func newBaselineEntry(d *Diagnostic) *BaselineEntry {
+	return &BaselineEntry{
+		Count:       1,
+		Fingerprint: Fingerprint(d),
+		Message:     d.Message,
+		Path:        filepath.ToSlash(d.Path),
+		Rule:        d.Rule,
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright IBM Corp. 2019, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package diagnostic
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestFingerprint(t *testing.T) {
+	a := &Diagnostic{Message: "exceeded maximum (500000) size of documentation file for Terraform Registry: 512345"}
+	b := &Diagnostic{Message: "exceeded maximum (500000) size of documentation file for  Terraform Registry: 600001"}
+	c := &Diagnostic{Message: "import section heading (Imports) should be: Import"}
+
+	if Fingerprint(a) != Fingerprint(b) {
+		t.Errorf("expected equal fingerprints for normalized messages")
+	}
+
+	if Fingerprint(a) == Fingerprint(c) {
+		t.Errorf("expected different fingerprints for different messages")
+	}
+}
+
+func TestBaselineFilter(t *testing.T) {
+	accepted := []*Diagnostic{
+		{Line: 3, Message: "YAML frontmatter should not contain layout", Path: "docs/resources/one.md", Rule: "frontmatter"},
+		{Line: 10, Message: "import section should not include \"e.g\"", Path: "docs/resources/one.md", Rule: "import-section"},
+		{Line: 12, Message: "import section should not include \"e.g\"", Path: "docs/resources/one.md", Rule: "import-section"},
+		{Line: 7, Message: "example section heading level (3) should be: 2", Path: "docs/resources/two.md", Rule: "example-section"},
+	}
+
+	baseline := NewBaseline(accepted)
+
+	if len(baseline.Entries) != 3 {
+		t.Fatalf("expected 3 baseline entries, got %d", len(baseline.Entries))
+	}
+
+	path := filepath.Join(t.TempDir(), "baseline.json")
+
+	if err := baseline.WriteFile(path); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	baseline, err := ReadBaseline(path)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	current := []*Diagnostic{
+		// Moved lines remain accepted.
+		{Line: 4, Message: "YAML frontmatter should not contain layout", Path: "docs/resources/one.md", Rule: "frontmatter"},
+		{Line: 11, Message: "import section should not include \"e.g\"", Path: "docs/resources/one.md", Rule: "import-section"},
+		{Line: 2, Message: "YAML frontmatter should not contain layout", Path: "docs/resources/two.md", Rule: "frontmatter"},
+	}
+
+	got, stale := baseline.Filter(current)
+
+	if len(got) != 1 || got[0].Path != "docs/resources/two.md" || got[0].Rule != "frontmatter" {
+		t.Errorf("expected only new docs/resources/two.md frontmatter finding, got %v", got)
+	}
+
+	if len(stale) != 2 {
+		t.Fatalf("expected 2 stale entries, got %d", len(stale))
+	}
+
+	if stale[0].Rule != "import-section" || stale[0].Count != 1 {
+		t.Errorf("expected stale import-section entry with count 1, got %s with count %d", stale[0].Rule, stale[0].Count)
+	}
+
+	if stale[1].Rule != "example-section" || stale[1].Count != 1 {
+		t.Errorf("expected stale example-section entry with count 1, got %s with count %d", stale[1].Rule, stale[1].Count)
+	}
+}
@@ -0,0 +1,132 @@
+// Copyright IBM Corp. 2019, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package diagnostic
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+)
+
+// Severity represents the importance of a Diagnostic.
+type Severity string
+
+// SeverityError is the severity of every finding, which fails the check.
+const SeverityError Severity = "error"
+
+// Diagnostic represents a single finding of a documentation check.
+//
+// Diagnostic implements error, so checks can continue to return and aggregate
+// findings as errors.
+type Diagnostic struct {
+	// Path is the documentation file path, if the finding applies to a file.
+	Path string
+
+	// Line is the 1-based line number, or 0 if the finding applies to the
+	// whole file.
+	Line int
+
+	// Column is the 1-based column number, or 0 if unknown.
+	Column int
+
+	// Rule is the stable identifier of the check reporting the finding.
+	Rule string
+
+	Severity Severity
+	Message  string
+
+	// Suggestion optionally describes how to resolve the finding.
+	Suggestion string
+}
+
+// New returns an error severity Diagnostic for the rule.
+func New(rule string, format string, a ...any) *Diagnostic {
+	return &Diagnostic{
+		Message:  fmt.Sprintf(format, a...),
+		Rule:     rule,
+		Severity: SeverityError,
+	}
+}
+
+// Error returns the Diagnostic formatted as path:line:column: message (rule).
+func (d *Diagnostic) Error() string {
+	var builder strings.Builder
+
+	if d.Path != "" {
+		builder.WriteString(d.Path)
+
+		if d.Line > 0 {
+			fmt.Fprintf(&builder, ":%d", d.Line)
+
+			if d.Column > 0 {
+				fmt.Fprintf(&builder, ":%d", d.Column)
+			}
+		}
+
+		builder.WriteString(": ")
+	}
+
+	builder.WriteString(d.Message)
+
+	if d.Rule != "" {
+		fmt.Fprintf(&builder, " (%s)", d.Rule)
+	}
+
+	return builder.String()
+}
+
+// Compare orders diagnostics by path, then line, then column, with rule and
+// message breaking ties. It is suitable for slices.SortFunc.
+func Compare(a, b *Diagnostic) int {
+	return cmp.Or(
+		cmp.Compare(a.Path, b.Path),
+		cmp.Compare(a.Line, b.Line),
+		cmp.Compare(a.Column, b.Column),
+		cmp.Compare(a.Rule, b.Rule),
+		cmp.Compare(a.Message, b.Message),
+	)
+}
+
+// FromError returns all diagnostics contained in err, flattening any
+// multierror. Errors which are not diagnostics are returned as error severity
+// diagnostics without a position or rule.
+func FromError(err error) []*Diagnostic {
+	if err == nil {
+		return nil
+	}
+
+	var merr *multierror.Error
+
+	if errors.As(err, &merr) {
+		var result []*Diagnostic
+
+		for _, err := range merr.Errors {
+			result = append(result, FromError(err)...)
+		}
+
+		return result
+	}
+
+	var d *Diagnostic
+
+	if errors.As(err, &d) {
+		return []*Diagnostic{d}
+	}
+
+	return []*Diagnostic{
+		{
+			Message:  err.Error(),
+			Severity: SeverityError,
+		},
+	}
+}
+
+// Rule describes a check which reports diagnostics.
+type Rule struct {
+	Description string
+	ID          string
+}
@@ -0,0 +1,136 @@
+// Copyright IBM Corp. 2019, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package diagnostic
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/go-multierror"
+)
+
+func TestDiagnosticError(t *testing.T) {
+	testCases := []struct {
+		Name       string
+		Diagnostic *Diagnostic
+		Expect     string
+	}{
+		{
+			Name:       "message",
+			Diagnostic: &Diagnostic{Message: "test"},
+			Expect:     "test",
+		},
+		{
+			Name:       "rule",
+			Diagnostic: &Diagnostic{Message: "test", Rule: "test-rule"},
+			Expect:     "test (test-rule)",
+		},
+		{
+			Name:       "path",
+			Diagnostic: &Diagnostic{Message: "test", Path: "docs/index.md", Rule: "test-rule"},
+			Expect:     "docs/index.md: test (test-rule)",
+		},
+		{
+			Name:       "line",
+			Diagnostic: &Diagnostic{Line: 3, Message: "test", Path: "docs/index.md", Rule: "test-rule"},
+			Expect:     "docs/index.md:3: test (test-rule)",
+		},
+		{
+			Name:       "column",
+			Diagnostic: &Diagnostic{Column: 5, Line: 3, Message: "test", Path: "docs/index.md", Rule: "test-rule"},
+			Expect:     "docs/index.md:3:5: test (test-rule)",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			if got := testCase.Diagnostic.Error(); got != testCase.Expect {
+				t.Errorf("expected %q, got %q", testCase.Expect, got)
+			}
+		})
+	}
+}
+
+func TestFromError(t *testing.T) {
+	testCases := []struct {
+		Name   string
+		Err    error
+		Expect []string
+	}{
+		{
+			Name: "nil",
+		},
+		{
+			Name:   "error",
+			Err:    errors.New("test"),
+			Expect: []string{"test"},
+		},
+		{
+			Name:   "wrapped diagnostic",
+			Err:    fmt.Errorf("wrapped: %w", New("test-rule", "test")),
+			Expect: []string{"test (test-rule)"},
+		},
+		{
+			Name: "multierror",
+			Err: multierror.Append(
+				New("test-rule", "test1"),
+				errors.New("test2"),
+				multierror.Append(nil, New("test-rule", "test3")),
+			),
+			Expect: []string{"test1 (test-rule)", "test2", "test3 (test-rule)"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := FromError(testCase.Err)
+
+			if len(got) != len(testCase.Expect) {
+				t.Fatalf("expected %d diagnostics, got %d: %v", len(testCase.Expect), len(got), got)
+			}
+
+			for i, d := range got {
+				if d.Severity != SeverityError {
+					t.Errorf("expected severity %s, got %s", SeverityError, d.Severity)
+				}
+
+				if d.Error() != testCase.Expect[i] {
+					t.Errorf("expected %q, got %q", testCase.Expect[i], d.Error())
+				}
+			}
+		})
+	}
+}
+
+func TestCompare(t *testing.T) {
+	diagnostics := []*Diagnostic{
+		{Column: 1, Line: 10, Message: "test", Path: "docs/b.md", Rule: "test-rule"},
+		{Column: 1, Line: 10, Message: "test", Path: "docs/a.md", Rule: "test-rule"},
+		{Column: 5, Line: 9, Message: "test", Path: "docs/a.md", Rule: "test-rule"},
+		{Column: 1, Line: 9, Message: "test", Path: "docs/a.md", Rule: "test-rule"},
+		{Message: "test", Path: "docs/a.md", Rule: "test-rule"},
+	}
+
+	slices.SortFunc(diagnostics, Compare)
+
+	var got []string
+
+	for _, d := range diagnostics {
+		got = append(got, d.Error())
+	}
+
+	expect := []string{
+		"docs/a.md: test (test-rule)",
+		"docs/a.md:9:1: test (test-rule)",
+		"docs/a.md:9:5: test (test-rule)",
+		"docs/a.md:10:1: test (test-rule)",
+		"docs/b.md:10:1: test (test-rule)",
+	}
+
+	if !slices.Equal(got, expect) {
+		t.Errorf("expected %q, got %q", expect, got)
+	}
+}
@@ -0,0 +1,10 @@
+// Copyright IBM Corp. 2019, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Package diagnostic describes findings of Terraform Provider documentation
+// checks, including their file position and rule.
+//
+// Deprecated: tfproviderdocs is no longer maintained. All functionality has
+// been superseded by github.com/YakDriver/swissshepherd. Please migrate:
+// https://github.com/YakDriver/swissshepherd
+package diagnostic
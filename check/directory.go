@@ -8,6 +8,7 @@ import (
 	"path/filepath"
 	"slices"
 
+	"github.com/YakDriver/tfproviderdocs/check/diagnostic"
 	"github.com/bmatcuk/doublestar"
 )
 
@@ -35,6 +36,72 @@ const (
 	RegistryResourcesDirectory     = `resources`
 )
 
+// Kind represents a kind of schema documentation, such as resources or data
+// sources, and its documentation directories.
+type Kind struct {
+	LegacyDirectory string
+
+	// Noun is the name of the kind in section bylines, e.g. data source.
+	Noun string
+
+	RegistryDirectory string
+	ResourceType      string
+}
+
+var (
+	KindAction = &Kind{
+		LegacyDirectory:   LegacyActionsDirectory,
+		Noun:              "action",
+		RegistryDirectory: RegistryActionsDirectory,
+		ResourceType:      ResourceTypeAction,
+	}
+
+	KindDataSource = &Kind{
+		LegacyDirectory:   LegacyDataSourcesDirectory,
+		Noun:              "data source",
+		RegistryDirectory: RegistryDataSourcesDirectory,
+		ResourceType:      ResourceTypeDataSource,
+	}
+
+	KindEphemeral = &Kind{
+		LegacyDirectory:   LegacyEphemeralsDirectory,
+		Noun:              "ephemeral resource",
+		RegistryDirectory: RegistryEphemeralsDirectory,
+		ResourceType:      ResourceTypeEphemeral,
+	}
+
+	KindFunction = &Kind{
+		LegacyDirectory:   LegacyFunctionsDirectory,
+		Noun:              "function",
+		RegistryDirectory: RegistryFunctionsDirectory,
+		ResourceType:      ResourceTypeFunction,
+	}
+
+	KindListResource = &Kind{
+		LegacyDirectory:   LegacyListResourcesDirectory,
+		Noun:              "list resource",
+		RegistryDirectory: RegistryListResourcesDirectory,
+		ResourceType:      ResourceTypeListResource,
+	}
+
+	KindResource = &Kind{
+		LegacyDirectory:   LegacyResourcesDirectory,
+		Noun:              "resource",
+		RegistryDirectory: RegistryResourcesDirectory,
+		ResourceType:      ResourceTypeResource,
+	}
+)
+
+// Kinds contains every kind of schema documentation.
+var Kinds = []*Kind{
+	KindAction,
+	KindDataSource,
+	KindEphemeral,
+	KindFunction,
+	KindListResource,
+	KindResource,
+}
+
 var ValidLegacyDirectories = []string{
 	LegacyIndexDirectory,
 	LegacyIndexDirectory + "/" + LegacyActionsDirectory,
@@ -99,7 +166,10 @@ func InvalidDirectoriesCheck(directories map[string][]string) error {
 			continue
 		}
 
-		return fmt.Errorf("invalid Terraform Provider documentation directory found: %s", directory)
+		d := diagnostic.New(RuleDirectoryInvalid, "invalid Terraform Provider documentation directory found: %s", directory)
+		d.Path = directory
+
+		return d
 	}
 
 	return nil
@@ -108,7 +178,7 @@ func InvalidDirectoriesCheck(directories map[string][]string) error {
 func MixedDirectoriesCheck(directories map[string][]string) error {
 	var legacyDirectoryFound bool
 	var registryDirectoryFound bool
-	err := fmt.Errorf("mixed Terraform Provider documentation directory layouts found, must use only legacy or registry layout")
+	err := diagnostic.New(RuleDirectoryMixed, "mixed Terraform Provider documentation directory layouts found, must use only legacy or registry layout")
 
 	for directory := range directories {
 		// Allow docs/ with other files
@@ -9,6 +9,11 @@ import (
 	"os"
 	"path/filepath"
 	"slices"
+	"sync"
+
+	"github.com/YakDriver/tfproviderdocs/check/contents"
+	"github.com/YakDriver/tfproviderdocs/check/diagnostic"
+	"github.com/hashicorp/go-multierror"
 )
 
 type FileCheck interface {
@@ -16,8 +21,26 @@ type FileCheck interface {
 	RunAll([]string) error
 }
 
+// File is a documentation file, which is read and parsed once and then shared
+// by every check of the file.
+type File struct {
+	// Contents is the parsed Markdown document, set by the contents check.
+	Contents *contents.Document
+
+	// FrontMatter is the parsed YAML frontmatter, set by the frontmatter check.
+	FrontMatter *FrontMatterData
+
+	FullPath string
+	Path     string
+	Source   []byte
+}
+
 type FileOptions struct {
 	BasePath string
+
+	// Parallelism is the maximum number of files of a directory checked
+	// concurrently. Values below 1 check files serially.
+	Parallelism int
 }
 
 // FullPath returns the full path of the file, combining path portions from opts.BasePath and path.
@@ -29,6 +52,60 @@ func (opts *FileOptions) FullPath(path string) string {
 	return path
 }
 
+// ReadFile reads the documentation file path, relative to opts.BasePath.
+func (opts *FileOptions) ReadFile(path string) (*File, error) {
+	fullpath := opts.FullPath(path)
+	source, err := os.ReadFile(fullpath)
+
+	if err != nil {
+		return nil, err
+	}
+
+	file := &File{
+		FullPath: fullpath,
+		Path:     path,
+		Source:   source,
+	}
+
+	return file, nil
+}
+
+// runFiles calls run for each file, with at most opts.Parallelism calls in
+// flight. Errors are aggregated in the order of files, so results do not
+// depend on scheduling.
+func (opts *FileOptions) runFiles(files []string, run func(string) error) error {
+	errs := make([]error, len(files))
+	sem := make(chan struct{}, max(opts.Parallelism, 1))
+
+	var wg sync.WaitGroup
+
+	for i, file := range files {
+		wg.Add(1)
+		sem <- struct{}{}
+
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			errs[i] = run(file)
+		}()
+	}
+
+	wg.Wait()
+
+	var result *multierror.Error
+
+	for _, err := range errs {
+		if err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
 // FileSizeCheck verifies that documentation file is below the Terraform Registry storage limit.
 func FileSizeCheck(fullpath string) error {
 	fi, err := os.Stat(fullpath)
@@ -45,6 +122,26 @@ func FileSizeCheck(fullpath string) error {
 	return nil
 }
 
+// fileDiagnostics returns the findings of err as diagnostics of the
+// documentation file path. Errors which are not diagnostics are reported with
+// the rule. Each diagnostic of a multierror is returned individually so that
+// every finding is reported on its own when aggregated.
+func fileDiagnostics(path string, rule string, err error) error {
+	var result *multierror.Error
+
+	for _, d := range diagnostic.FromError(err) {
+		d.Path = path
+
+		if d.Rule == "" {
+			d.Rule = rule
+		}
+
+		result = multierror.Append(result, d)
+	}
+
+	return result.ErrorOrNil()
+}
+
 const (
 	FileIgnoreDSStore = `.DS_Store`
 )
@@ -8,6 +8,7 @@ import (
 	"log"
 	"slices"
 
+	"github.com/YakDriver/tfproviderdocs/check/diagnostic"
 	"github.com/hashicorp/go-multierror"
 )
 
@@ -57,7 +58,6 @@ func (check *FileMismatchCheck) Run(files []string) error {
 	}
 
 	var extraFiles []string
-	var missingFiles []string
 
 	for _, file := range files {
 		if fileHasResource(check.Options.ResourceNames, check.Options.ProviderName, file) {
@@ -71,6 +71,28 @@ func (check *FileMismatchCheck) Run(files []string) error {
 		extraFiles = append(extraFiles, file)
 	}
 
+	missingFiles := check.MissingResourceNames(files)
+
+	var result *multierror.Error
+
+	for _, extraFile := range extraFiles {
+		d := diagnostic.New(RuleFileExtraneous, "matching %s for documentation file (%s) not found, file is extraneous or incorrectly named", check.Options.ResourceType, extraFile)
+		d.Path = extraFile
+		result = multierror.Append(result, d)
+	}
+
+	for _, missingFile := range missingFiles {
+		result = multierror.Append(result, diagnostic.New(RuleFileMissing, "missing documentation file for %s: %s", check.Options.ResourceType, missingFile))
+	}
+
+	return result.ErrorOrNil()
+}
+
+// MissingResourceNames returns the resource names without a documentation
+// file, except ignored resource names.
+func (check *FileMismatchCheck) MissingResourceNames(files []string) []string {
+	var result []string
+
 	for _, resourceName := range check.Options.ResourceNames {
 		if resourceHasFile(files, check.Options.ProviderName, resourceName) {
 			continue
@@ -80,30 +102,18 @@ func (check *FileMismatchCheck) Run(files []string) error {
 			continue
 		}
 
-		missingFiles = append(missingFiles, resourceName)
+		result = append(result, resourceName)
 	}
 
-	var result *multierror.Error
-
-	for _, extraFile := range extraFiles {
-		err := fmt.Errorf("matching %s for documentation file (%s) not found, file is extraneous or incorrectly named", check.Options.ResourceType, extraFile)
-		result = multierror.Append(result, err)
-	}
-
-	for _, missingFile := range missingFiles {
-		err := fmt.Errorf("missing documentation file for %s: %s", check.Options.ResourceType, missingFile)
-		result = multierror.Append(result, err)
-	}
-
-	return result.ErrorOrNil()
+	return result
 }
 
 func (check *FileMismatchCheck) IgnoreFileMismatch(file string) bool {
-	return slices.Contains(check.Options.IgnoreFileMismatch, fileResourceName(check.Options.ProviderName, file))
+	return MatchAnyPattern(check.Options.IgnoreFileMismatch, fileResourceName(check.Options.ProviderName, file))
 }
 
 func (check *FileMismatchCheck) IgnoreFileMissing(resourceName string) bool {
-	return slices.Contains(check.Options.IgnoreFileMissing, resourceName)
+	return MatchAnyPattern(check.Options.IgnoreFileMissing, resourceName)
 }
 
 func fileHasResource(resourceNames []string, providerName, file string) bool {
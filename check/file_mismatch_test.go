@@ -139,6 +139,21 @@ func TestFileMismatchCheck(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name: "ignore extra file glob",
+			Files: []string{
+				"resource1.md",
+				"resource2.md",
+				"resource3.md",
+			},
+			Options: &FileMismatchOptions{
+				IgnoreFileMismatch: []string{"test_resource*"},
+				ProviderName:       "test",
+				ResourceNames: []string{
+					"test_resource1",
+				},
+			},
+		},
 		{
 			Name: "missing file",
 			Files: []string{
@@ -167,6 +182,21 @@ func TestFileMismatchCheck(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name: "ignore missing file regexp",
+			Files: []string{
+				"resource1.md",
+			},
+			Options: &FileMismatchOptions{
+				IgnoreFileMissing: []string{"re:test_resource[2-3]"},
+				ProviderName:      "test",
+				ResourceNames: []string{
+					"test_resource1",
+					"test_resource2",
+					"test_resource3",
+				},
+			},
+		},
 		{
 			Name: "no files",
 			Options: &FileMismatchOptions{
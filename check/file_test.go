@@ -4,8 +4,15 @@
 package check
 
 import (
+	"errors"
 	"os"
+	"slices"
+	"sync/atomic"
 	"testing"
+	"time"
+
+	"github.com/YakDriver/tfproviderdocs/check/diagnostic"
+	"github.com/hashicorp/go-multierror"
 )
 
 func TestFileSizeCheck(t *testing.T) {
@@ -131,3 +138,120 @@ func TestFileIgnoreCheck(t *testing.T) {
 		})
 	}
 }
+
+func TestFileDiagnostics(t *testing.T) {
+	testCases := []struct {
+		Name   string
+		Err    error
+		Expect []string
+	}{
+		{
+			Name:   "error",
+			Err:    errors.New("test"),
+			Expect: []string{"docs/resources/thing.md: test (test-rule)"},
+		},
+		{
+			Name: "diagnostic",
+			Err: &diagnostic.Diagnostic{
+				Column:  1,
+				Line:    3,
+				Message: "test",
+				Path:    "website/docs/r/thing.html.markdown",
+				Rule:    "other-rule",
+			},
+			Expect: []string{"docs/resources/thing.md:3:1: test (other-rule)"},
+		},
+		{
+			Name: "multierror",
+			Err:  multierror.Append(nil, errors.New("test1"), errors.New("test2")),
+			Expect: []string{
+				"docs/resources/thing.md: test1 (test-rule)",
+				"docs/resources/thing.md: test2 (test-rule)",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			var got []string
+
+			err := fileDiagnostics("docs/resources/thing.md", "test-rule", testCase.Err)
+
+			for _, d := range diagnostic.FromError(err) {
+				got = append(got, d.Error())
+			}
+
+			if !slices.Equal(got, testCase.Expect) {
+				t.Errorf("expected %v, got %v", testCase.Expect, got)
+			}
+		})
+	}
+}
+
+func TestFileOptionsRunFiles(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Parallelism int
+	}{
+		{
+			Name: "serial",
+		},
+		{
+			Name:        "parallel",
+			Parallelism: 4,
+		},
+		{
+			Name:        "parallelism above file count",
+			Parallelism: 100,
+		},
+	}
+
+	files := []string{"a.md", "b.md", "c.md", "d.md", "e.md", "f.md", "g.md", "h.md"}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			opts := &FileOptions{
+				Parallelism: testCase.Parallelism,
+			}
+
+			var running, maxRunning atomic.Int32
+
+			err := opts.runFiles(files, func(file string) error {
+				n := running.Add(1)
+				defer running.Add(-1)
+
+				for {
+					m := maxRunning.Load()
+
+					if n <= m || maxRunning.CompareAndSwap(m, n) {
+						break
+					}
+				}
+
+				time.Sleep(time.Millisecond)
+
+				if file == "c.md" {
+					return nil
+				}
+
+				return diagnostic.New(RuleFileRead, "%s", file)
+			})
+
+			if got, want := int(maxRunning.Load()), max(testCase.Parallelism, 1); got > want {
+				t.Errorf("expected at most %d concurrent runs, got %d", want, got)
+			}
+
+			var got []string
+
+			for _, d := range diagnostic.FromError(err) {
+				got = append(got, d.Message)
+			}
+
+			want := slices.DeleteFunc(slices.Clone(files), func(file string) bool { return file == "c.md" })
+
+			if !slices.Equal(got, want) {
+				t.Errorf("expected %v, got %v", want, got)
+			}
+		})
+	}
+}
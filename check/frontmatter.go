@@ -4,9 +4,9 @@
 package check
 
 import (
-	"fmt"
-	"slices"
+	"bytes"
 
+	"github.com/YakDriver/tfproviderdocs/check/diagnostic"
 	"gopkg.in/yaml.v2"
 )
 
@@ -50,56 +50,97 @@ func NewFrontMatterCheck(opts *FrontMatterOptions) *FrontMatterCheck {
 }
 
 func (check *FrontMatterCheck) Run(src []byte) (*string, error) {
-	frontMatter := FrontMatterData{}
+	file := &File{
+		Source: src,
+	}
+
+	if err := check.RunFile(file); err != nil {
+		return nil, err
+	}
+
+	return file.FrontMatter.Subcategory, nil
+}
+
+// RunFile checks the frontmatter of the documentation file, which is parsed
+// into file.FrontMatter unless already parsed.
+func (check *FrontMatterCheck) RunFile(file *File) error {
+	if file.FrontMatter == nil {
+		frontMatter := &FrontMatterData{}
 
-	err := yaml.Unmarshal([]byte(src), &frontMatter)
-	if err != nil {
-		return nil, fmt.Errorf("error parsing YAML frontmatter: %w", err)
+		if err := yaml.Unmarshal(file.Source, frontMatter); err != nil {
+			return diagnostic.New(RuleFrontMatter, "error parsing YAML frontmatter: %s", err)
+		}
+
+		file.FrontMatter = frontMatter
 	}
 
+	frontMatter := file.FrontMatter
+	src := file.Source
+
 	if check.Options.NoDescription && frontMatter.Description != nil {
-		return nil, fmt.Errorf("YAML frontmatter should not contain description")
+		return frontMatterDiagnostic(src, "description", "YAML frontmatter should not contain description")
 	}
 
 	if check.Options.NoLayout && frontMatter.Layout != nil {
-		return nil, fmt.Errorf("YAML frontmatter should not contain layout")
+		return frontMatterDiagnostic(src, "layout", "YAML frontmatter should not contain layout")
 	}
 
 	if check.Options.NoPageTitle && frontMatter.PageTitle != nil {
-		return nil, fmt.Errorf("YAML frontmatter should not contain page_title")
+		return frontMatterDiagnostic(src, "page_title", "YAML frontmatter should not contain page_title")
 	}
 
 	if check.Options.NoSidebarCurrent && frontMatter.SidebarCurrent != nil {
-		return nil, fmt.Errorf("YAML frontmatter should not contain sidebar_current")
+		return frontMatterDiagnostic(src, "sidebar_current", "YAML frontmatter should not contain sidebar_current")
 	}
 
 	if check.Options.NoSubcategory && frontMatter.Subcategory != nil {
-		return nil, fmt.Errorf("YAML frontmatter should not contain subcategory")
+		return frontMatterDiagnostic(src, "subcategory", "YAML frontmatter should not contain subcategory")
 	}
 
 	if check.Options.RequireDescription && frontMatter.Description == nil {
-		return nil, fmt.Errorf("YAML frontmatter missing required description")
+		return diagnostic.New(RuleFrontMatter, "YAML frontmatter missing required description")
 	}
 
 	if check.Options.RequireLayout && frontMatter.Layout == nil {
-		return nil, fmt.Errorf("YAML frontmatter missing required layout")
+		return diagnostic.New(RuleFrontMatter, "YAML frontmatter missing required layout")
 	}
 
 	if check.Options.RequirePageTitle && frontMatter.PageTitle == nil {
-		return nil, fmt.Errorf("YAML frontmatter missing required page_title")
+		return diagnostic.New(RuleFrontMatter, "YAML frontmatter missing required page_title")
 	}
 
 	if check.Options.RequireSubcategory && frontMatter.Subcategory == nil {
-		return nil, fmt.Errorf("YAML frontmatter missing required subcategory")
+		return diagnostic.New(RuleFrontMatter, "YAML frontmatter missing required subcategory")
 	}
 
 	if len(check.Options.AllowedSubcategories) > 0 && frontMatter.Subcategory != nil && !isAllowedSubcategory(*frontMatter.Subcategory, check.Options.AllowedSubcategories) {
-		return nil, fmt.Errorf("YAML frontmatter subcategory (%s) does not match allowed subcategories (%#v)", *frontMatter.Subcategory, check.Options.AllowedSubcategories)
+		return frontMatterDiagnostic(src, "subcategory", "YAML frontmatter subcategory (%s) does not match allowed subcategories (%#v)", *frontMatter.Subcategory, check.Options.AllowedSubcategories)
+	}
+
+	return nil
+}
+
+// frontMatterDiagnostic returns a frontmatter finding positioned at the line of
+// the YAML frontmatter key, if found.
+func frontMatterDiagnostic(src []byte, key string, format string, a ...any) *diagnostic.Diagnostic {
+	result := diagnostic.New(RuleFrontMatter, format, a...)
+
+	for i, line := range bytes.Split(src, []byte("\n")) {
+		if i > 0 && bytes.Equal(bytes.TrimSpace(line), []byte("---")) {
+			break
+		}
+
+		if bytes.HasPrefix(line, []byte(key+":")) {
+			result.Line = i + 1
+			result.Column = 1
+
+			break
+		}
 	}
 
-	return frontMatter.Subcategory, nil
+	return result
 }
 
 func isAllowedSubcategory(subcategory string, allowedSubcategories []string) bool {
-	return slices.Contains(allowedSubcategories, subcategory)
+	return MatchAnyPattern(allowedSubcategories, subcategory)
 }
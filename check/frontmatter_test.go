@@ -4,7 +4,10 @@
 package check
 
 import (
+	"errors"
 	"testing"
+
+	"github.com/YakDriver/tfproviderdocs/check/diagnostic"
 )
 
 func TestFrontMatterCheck(t *testing.T) {
@@ -68,6 +71,20 @@ subcategory: Example Subcategory
 				AllowedSubcategories: []string{"Example Subcategory"},
 			},
 		},
+		{
+			Name: "allowed subcategory option matching glob",
+			Source: `
+description: |-
+  Example description
+layout: "example"
+page_title: Example Page Title
+subcategory: Example Subcategory
+`,
+			ExpectSubcategory: "Example Subcategory",
+			Options: &FrontMatterOptions{
+				AllowedSubcategories: []string{"Example *"},
+			},
+		},
 		{
 			Name: "allowed subcategory option not matching",
 			Source: `
@@ -224,3 +241,85 @@ page_title: Example Page Title
 		})
 	}
 }
+
+func TestFrontMatterCheckDiagnostic(t *testing.T) {
+	testCases := []struct {
+		Name       string
+		Source     string
+		Options    *FrontMatterOptions
+		ExpectLine int
+	}{
+		{
+			Name: "present key",
+			Source: `---
+description: |-
+  Example description
+layout: "example"
+---
+`,
+			Options: &FrontMatterOptions{
+				NoLayout: true,
+			},
+			ExpectLine: 4,
+		},
+		{
+			Name: "missing key",
+			Source: `---
+description: |-
+  Example description
+---
+`,
+			Options: &FrontMatterOptions{
+				RequireLayout: true,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			_, err := NewFrontMatterCheck(testCase.Options).Run([]byte(testCase.Source))
+
+			var d *diagnostic.Diagnostic
+
+			if !errors.As(err, &d) {
+				t.Fatalf("expected diagnostic, got: %v", err)
+			}
+
+			if d.Rule != RuleFrontMatter {
+				t.Errorf("expected rule %s, got %s", RuleFrontMatter, d.Rule)
+			}
+
+			if d.Line != testCase.ExpectLine {
+				t.Errorf("expected line %d, got %d", testCase.ExpectLine, d.Line)
+			}
+		})
+	}
+}
+
+func TestFrontMatterCheckRunFile(t *testing.T) {
+	subcategory := "Parsed Subcategory"
+	file := &File{
+		FrontMatter: &FrontMatterData{
+			Subcategory: &subcategory,
+		},
+		Source: []byte("subcategory: Source Subcategory\n"),
+	}
+
+	err := NewFrontMatterCheck(&FrontMatterOptions{AllowedSubcategories: []string{"Parsed Subcategory"}}).RunFile(file)
+
+	if err != nil {
+		t.Errorf("expected already parsed frontmatter to be checked, got error: %s", err)
+	}
+
+	file = &File{
+		Source: []byte("subcategory: Source Subcategory\n"),
+	}
+
+	if err := NewFrontMatterCheck(nil).RunFile(file); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if file.FrontMatter == nil || file.FrontMatter.Subcategory == nil || *file.FrontMatter.Subcategory != "Source Subcategory" {
+		t.Errorf("expected parsed frontmatter subcategory, got: %#v", file.FrontMatter)
+	}
+}
@@ -4,12 +4,8 @@
 package check
 
 import (
-	"fmt"
 	"log"
-	"os"
 	"path/filepath"
-
-	"github.com/hashicorp/go-multierror"
 )
 
 type LegacyActionFileOptions struct {
@@ -39,6 +35,10 @@ func NewLegacyActionFileCheck(opts *LegacyActionFileOptions) *LegacyActionFileCh
 		check.Options.Contents = &ContentsOptions{}
 	}
 
+	if check.Options.Contents.SchemaBlockType == "" {
+		check.Options.Contents.SchemaBlockType = "action"
+	}
+
 	check.Options.Contents.Enable = true
 
 	if check.Options.Contents.ProviderName == "" {
@@ -67,6 +67,12 @@ func NewLegacyActionFileCheck(opts *LegacyActionFileOptions) *LegacyActionFileCh
 		check.Options.FileOptions = &FileOptions{}
 	}
 
+	// Share file options with the contents check, which is otherwise
+	// defaulted when files are checked concurrently.
+	if check.Options.Contents.FileOptions == nil {
+		check.Options.Contents.FileOptions = check.Options.FileOptions
+	}
+
 	if check.Options.FrontMatter == nil {
 		check.Options.FrontMatter = &FrontMatterOptions{}
 	}
@@ -95,41 +101,33 @@ func (check *LegacyActionFileCheck) Run(path string, exampleLanguage string) err
 	}
 
 	if err := LegacyFileExtensionCheck(path); err != nil {
-		return fmt.Errorf("%s: error checking file extension: %w", path, err)
+		return fileDiagnostics(path, RuleFileExtension, err)
 	}
 
 	if err := FileSizeCheck(fullpath); err != nil {
-		return fmt.Errorf("%s: error checking file size: %w", path, err)
+		return fileDiagnostics(path, RuleFileSize, err)
 	}
 
-	content, err := os.ReadFile(fullpath)
+	file, err := check.Options.ReadFile(path)
 
 	if err != nil {
-		return fmt.Errorf("%s: error reading file: %w", path, err)
+		return fileDiagnostics(path, RuleFileRead, err)
 	}
 
-	subcategory, err := NewFrontMatterCheck(check.Options.FrontMatter).Run(content)
-
-	if err != nil {
-		return fmt.Errorf("%s: error checking file frontmatter: %w", path, err)
+	if err := NewFrontMatterCheck(check.Options.FrontMatter).RunFile(file); err != nil {
+		return fileDiagnostics(path, RuleFrontMatter, err)
 	}
 
 	if !IsValidCdktfDirectory(filepath.Dir(fullpath)) {
-		if err := NewContentsCheck(check.Options.Contents).Run(fullpath, exampleLanguage, subcategory); err != nil {
-			return fmt.Errorf("%s: error checking file contents: %w", path, err)
+		if err := NewContentsCheck(check.Options.Contents).Run(file, exampleLanguage); err != nil {
+			return fileDiagnostics(path, RuleContentsParse, err)
 		}
 	}
 	return nil
 }
 
 func (check *LegacyActionFileCheck) RunAll(files []string, exampleLanguage string) error {
-	var result *multierror.Error
-
-	for _, file := range files {
-		if err := check.Run(file, exampleLanguage); err != nil {
-			result = multierror.Append(result, err)
-		}
-	}
-
-	return result.ErrorOrNil()
+	return check.Options.runFiles(files, func(file string) error {
+		return check.Run(file, exampleLanguage)
+	})
 }
@@ -4,12 +4,8 @@
 package check
 
 import (
-	"fmt"
 	"log"
-	"os"
 	"path/filepath"
-
-	"github.com/hashicorp/go-multierror"
 )
 
 type LegacyEphemeralFileOptions struct {
@@ -39,6 +35,10 @@ func NewLegacyEphemeralFileCheck(opts *LegacyEphemeralFileOptions) *LegacyEpheme
 		check.Options.Contents = &ContentsOptions{}
 	}
 
+	if check.Options.Contents.SchemaBlockType == "" {
+		check.Options.Contents.SchemaBlockType = "ephemeral"
+	}
+
 	if check.Options.Contents.ProviderName == "" {
 		check.Options.Contents.ProviderName = check.Options.ProviderName
 	}
@@ -47,6 +47,12 @@ func NewLegacyEphemeralFileCheck(opts *LegacyEphemeralFileOptions) *LegacyEpheme
 		check.Options.FileOptions = &FileOptions{}
 	}
 
+	// Share file options with the contents check, which is otherwise
+	// defaulted when files are checked concurrently.
+	if check.Options.Contents.FileOptions == nil {
+		check.Options.Contents.FileOptions = check.Options.FileOptions
+	}
+
 	if check.Options.FrontMatter == nil {
 		check.Options.FrontMatter = &FrontMatterOptions{}
 	}
@@ -76,42 +82,34 @@ func (check *LegacyEphemeralFileCheck) Run(path string, exampleLanguage string)
 	}
 
 	if err := LegacyFileExtensionCheck(path); err != nil {
-		return fmt.Errorf("%s: error checking file extension: %w", path, err)
+		return fileDiagnostics(path, RuleFileExtension, err)
 	}
 
 	if err := FileSizeCheck(fullpath); err != nil {
-		return fmt.Errorf("%s: error checking file size: %w", path, err)
+		return fileDiagnostics(path, RuleFileSize, err)
 	}
 
-	content, err := os.ReadFile(fullpath)
+	file, err := check.Options.ReadFile(path)
 
 	if err != nil {
-		return fmt.Errorf("%s: error reading file: %w", path, err)
+		return fileDiagnostics(path, RuleFileRead, err)
 	}
 
-	subcategory, err := NewFrontMatterCheck(check.Options.FrontMatter).Run(content)
-
-	if err != nil {
-		return fmt.Errorf("%s: error checking file frontmatter: %w", path, err)
+	if err := NewFrontMatterCheck(check.Options.FrontMatter).RunFile(file); err != nil {
+		return fileDiagnostics(path, RuleFrontMatter, err)
 	}
 
 	// We don't want to check the content for CDKTF files since they are converted
 	if !IsValidCdktfDirectory(filepath.Dir(fullpath)) {
-		if err := NewContentsCheck(check.Options.Contents).Run(fullpath, exampleLanguage, subcategory); err != nil {
-			return fmt.Errorf("%s: error checking file contents: %w", path, err)
+		if err := NewContentsCheck(check.Options.Contents).Run(file, exampleLanguage); err != nil {
+			return fileDiagnostics(path, RuleContentsParse, err)
 		}
 	}
 	return nil
 }
 
 func (check *LegacyEphemeralFileCheck) RunAll(files []string, exampleLanguage string) error {
-	var result *multierror.Error
-
-	for _, file := range files {
-		if err := check.Run(file, exampleLanguage); err != nil {
-			result = multierror.Append(result, err)
-		}
-	}
-
-	return result.ErrorOrNil()
+	return check.Options.runFiles(files, func(file string) error {
+		return check.Run(file, exampleLanguage)
+	})
 }
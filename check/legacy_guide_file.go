@@ -4,11 +4,7 @@
 package check
 
 import (
-	"fmt"
 	"log"
-	"os"
-
-	"github.com/hashicorp/go-multierror"
 )
 
 type LegacyGuideFileOptions struct {
@@ -59,36 +55,28 @@ func (check *LegacyGuideFileCheck) Run(path string) error {
 	}
 
 	if err := LegacyFileExtensionCheck(path); err != nil {
-		return fmt.Errorf("%s: error checking file extension: %w", path, err)
+		return fileDiagnostics(path, RuleFileExtension, err)
 	}
 
 	if err := FileSizeCheck(fullpath); err != nil {
-		return fmt.Errorf("%s: error checking file size: %w", path, err)
+		return fileDiagnostics(path, RuleFileSize, err)
 	}
 
-	content, err := os.ReadFile(fullpath)
+	file, err := check.Options.ReadFile(path)
 
 	if err != nil {
-		return fmt.Errorf("%s: error reading file: %w", path, err)
+		return fileDiagnostics(path, RuleFileRead, err)
 	}
 
-	_, err = NewFrontMatterCheck(check.Options.FrontMatter).Run(content)
-
-	if err != nil {
-		return fmt.Errorf("%s: error checking file frontmatter: %w", path, err)
+	if err := NewFrontMatterCheck(check.Options.FrontMatter).RunFile(file); err != nil {
+		return fileDiagnostics(path, RuleFrontMatter, err)
 	}
 
 	return nil
 }
 
 func (check *LegacyGuideFileCheck) RunAll(files []string) error {
-	var result *multierror.Error
-
-	for _, file := range files {
-		if err := check.Run(file); err != nil {
-			result = multierror.Append(result, err)
-		}
-	}
-
-	return result.ErrorOrNil()
+	return check.Options.runFiles(files, func(file string) error {
+		return check.Run(file)
+	})
 }
@@ -0,0 +1,76 @@
+// Copyright IBM Corp. 2019, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package check
+
+import (
+	"log"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/bmatcuk/doublestar"
+)
+
+const (
+	// PatternRegexpPrefix is the prefix of ignore list and allowed
+	// subcategory patterns which are regular expressions.
+	PatternRegexpPrefix = "re:"
+)
+
+// patternRegexps caches compiled regular expression patterns. Invalid
+// regular expressions are cached as nil.
+var patternRegexps sync.Map
+
+// MatchPattern returns true if the value matches the pattern of an ignore list
+// or allowed subcategories. Patterns prefixed with re: are regular expressions
+// which must match the whole value (e.g. re:aws_appstream_.+), other patterns
+// are doublestar globs (e.g. aws_appstream_*) or exact values.
+func MatchPattern(pattern string, value string) bool {
+	if pattern == value {
+		return true
+	}
+
+	if expr, ok := strings.CutPrefix(pattern, PatternRegexpPrefix); ok {
+		re := patternRegexp(expr)
+
+		return re != nil && re.MatchString(value)
+	}
+
+	matched, err := doublestar.Match(pattern, value)
+
+	if err != nil {
+		log.Printf("[WARN] Invalid glob pattern (%s): %s", pattern, err)
+		return false
+	}
+
+	return matched
+}
+
+// MatchAnyPattern returns true if the value matches any of the patterns.
+func MatchAnyPattern(patterns []string, value string) bool {
+	for _, pattern := range patterns {
+		if MatchPattern(pattern, value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func patternRegexp(expr string) *regexp.Regexp {
+	if v, ok := patternRegexps.Load(expr); ok {
+		return v.(*regexp.Regexp)
+	}
+
+	re, err := regexp.Compile(`^(?:` + expr + `)$`)
+
+	if err != nil {
+		log.Printf("[WARN] Invalid regular expression pattern (%s%s): %s", PatternRegexpPrefix, expr, err)
+		re = nil
+	}
+
+	patternRegexps.Store(expr, re)
+
+	return re
+}
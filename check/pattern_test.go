@@ -0,0 +1,76 @@
+// Copyright IBM Corp. 2019, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package check
+
+import (
+	"testing"
+)
+
+func TestMatchPattern(t *testing.T) {
+	testCases := []struct {
+		Name    string
+		Pattern string
+		Value   string
+		Expect  bool
+	}{
+		{
+			Name:    "exact",
+			Pattern: "aws_appstream_fleet",
+			Value:   "aws_appstream_fleet",
+			Expect:  true,
+		},
+		{
+			Name:    "exact not matching",
+			Pattern: "aws_appstream_fleet",
+			Value:   "aws_appstream_stack",
+		},
+		{
+			Name:    "exact with glob characters",
+			Pattern: "EC2 [Legacy]",
+			Value:   "EC2 [Legacy]",
+			Expect:  true,
+		},
+		{
+			Name:    "glob",
+			Pattern: "aws_appstream_*",
+			Value:   "aws_appstream_fleet",
+			Expect:  true,
+		},
+		{
+			Name:    "glob not matching",
+			Pattern: "aws_appstream_*",
+			Value:   "aws_appsync_api",
+		},
+		{
+			Name:    "glob alternatives",
+			Pattern: "aws_{appstream,appsync}_*",
+			Value:   "aws_appsync_api",
+			Expect:  true,
+		},
+		{
+			Name:    "regexp",
+			Pattern: "re:aws_app(stream|sync)_.+",
+			Value:   "aws_appsync_api",
+			Expect:  true,
+		},
+		{
+			Name:    "regexp whole value",
+			Pattern: "re:appstream",
+			Value:   "aws_appstream_fleet",
+		},
+		{
+			Name:    "invalid regexp",
+			Pattern: "re:aws_(",
+			Value:   "aws_(",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			if got := MatchPattern(testCase.Pattern, testCase.Value); got != testCase.Expect {
+				t.Errorf("expected %t, got %t", testCase.Expect, got)
+			}
+		})
+	}
+}
@@ -4,11 +4,7 @@
 package check
 
 import (
-	"fmt"
 	"log"
-	"os"
-
-	"github.com/hashicorp/go-multierror"
 )
 
 type RegistryFunctionFileOptions struct {
@@ -46,6 +42,12 @@ func NewRegistryFunctionFileCheck(opts *RegistryFunctionFileOptions) *RegistryFu
 		check.Options.FileOptions = &FileOptions{}
 	}
 
+	// Share file options with the contents check, which is otherwise
+	// defaulted when files are checked concurrently.
+	if check.Options.Contents.FileOptions == nil {
+		check.Options.Contents.FileOptions = check.Options.FileOptions
+	}
+
 	if check.Options.FrontMatter == nil {
 		check.Options.FrontMatter = &FrontMatterOptions{}
 	}
@@ -62,40 +64,32 @@ func (check *RegistryFunctionFileCheck) Run(path string, exampleLanguage string)
 	log.Printf("[DEBUG] Checking file: %s", fullpath)
 
 	if err := RegistryFileExtensionCheck(path); err != nil {
-		return fmt.Errorf("%s: error checking file extension: %w", path, err)
+		return fileDiagnostics(path, RuleFileExtension, err)
 	}
 
 	if err := FileSizeCheck(fullpath); err != nil {
-		return fmt.Errorf("%s: error checking file size: %w", path, err)
+		return fileDiagnostics(path, RuleFileSize, err)
 	}
 
-	content, err := os.ReadFile(fullpath)
+	file, err := check.Options.ReadFile(path)
 
 	if err != nil {
-		return fmt.Errorf("%s: error reading file: %w", path, err)
+		return fileDiagnostics(path, RuleFileRead, err)
 	}
 
-	subcategory, err := NewFrontMatterCheck(check.Options.FrontMatter).Run(content)
-
-	if err != nil {
-		return fmt.Errorf("%s: error checking file frontmatter: %w", path, err)
+	if err := NewFrontMatterCheck(check.Options.FrontMatter).RunFile(file); err != nil {
+		return fileDiagnostics(path, RuleFrontMatter, err)
 	}
 
-	if err := NewContentsCheck(check.Options.Contents).Run(fullpath, exampleLanguage, subcategory); err != nil {
-		return fmt.Errorf("%s: error checking file contents: %w", path, err)
+	if err := NewContentsCheck(check.Options.Contents).Run(file, exampleLanguage); err != nil {
+		return fileDiagnostics(path, RuleContentsParse, err)
 	}
 
 	return nil
 }
 
 func (check *RegistryFunctionFileCheck) RunAll(files []string, exampleLanguage string) error {
-	var result *multierror.Error
-
-	for _, file := range files {
-		if err := check.Run(file, exampleLanguage); err != nil {
-			result = multierror.Append(result, err)
-		}
-	}
-
-	return result.ErrorOrNil()
+	return check.Options.runFiles(files, func(file string) error {
+		return check.Run(file, exampleLanguage)
+	})
 }
@@ -0,0 +1,32 @@
+// Copyright IBM Corp. 2019, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package check
+
+import "github.com/YakDriver/tfproviderdocs/check/diagnostic"
+
+// Rule identifiers of directory and file check findings.
+const (
+	RuleContentsParse    = "contents-parse"
+	RuleDirectoryInvalid = "directory-invalid"
+	RuleDirectoryMixed   = "directory-mixed"
+	RuleFileExtension    = "file-extension"
+	RuleFileExtraneous   = "file-extraneous"
+	RuleFileMissing      = "file-missing"
+	RuleFileRead         = "file-read"
+	RuleFileSize         = "file-size"
+	RuleFrontMatter      = "frontmatter"
+)
+
+// Rules describes every rule of directory and file check findings.
+var Rules = []diagnostic.Rule{
+	{ID: RuleContentsParse, Description: "Documentation file contents can be parsed."},
+	{ID: RuleDirectoryInvalid, Description: "Documentation directories use a valid Terraform Registry, legacy, or CDK for Terraform layout."},
+	{ID: RuleDirectoryMixed, Description: "Documentation does not mix legacy and Terraform Registry directory layouts."},
+	{ID: RuleFileExtension, Description: "Documentation files use a valid file extension."},
+	{ID: RuleFileExtraneous, Description: "Documentation files match a provider schema name."},
+	{ID: RuleFileMissing, Description: "Provider schema names have a documentation file."},
+	{ID: RuleFileRead, Description: "Documentation files can be read."},
+	{ID: RuleFileSize, Description: "Documentation files are below the Terraform Registry storage limit."},
+	{ID: RuleFrontMatter, Description: "YAML frontmatter can be parsed and matches expectations."},
+}
@@ -12,12 +12,18 @@ import (
 	"log"
 	"os"
 	"path/filepath"
+	"runtime"
+	"slices"
 	"sort"
 	"strings"
 	"text/tabwriter"
 
 	"github.com/YakDriver/tfproviderdocs/check"
 	"github.com/YakDriver/tfproviderdocs/check/contents"
+	"github.com/YakDriver/tfproviderdocs/check/diagnostic"
+	"github.com/YakDriver/tfproviderdocs/report"
+	"github.com/YakDriver/tfproviderdocs/version"
+	"github.com/hashicorp/go-multierror"
 	tfjson "github.com/hashicorp/terraform-json"
 	"github.com/mitchellh/cli"
 )
@@ -27,8 +33,11 @@ type CheckCommandConfig struct {
 	AllowedGuideSubcategoriesFile              string
 	AllowedResourceSubcategories               string
 	AllowedResourceSubcategoriesFile           string
+	Baseline                                   string
+	ConfigFile                                 string
 	EnableContentsCheck                        bool
 	EnableEnhancedRegionCheck                  bool
+	Format                                     string
 	IgnoreCdktfMissingFiles                    bool
 	IgnoreContentsCheckDataSources             string
 	IgnoreContentsCheckActions                 string
@@ -56,6 +65,8 @@ type CheckCommandConfig struct {
 	IgnoreFileMissingListResources             string
 	IgnoreFileMissingResources                 string
 	LogLevel                                   string
+	Output                                     string
+	Parallelism                                int
 	Path                                       string
 	ProviderName                               string
 	ProviderSource                             string
@@ -63,6 +74,21 @@ type CheckCommandConfig struct {
 	RequireGuideSubcategory                    bool
 	RequireResourceSubcategory                 bool
 	RequireSchemaOrdering                      bool
+	WriteBaseline                              string
+
+	// configFileLists holds the list settings of the configuration file,
+	// keyed by flag name, which apply if the flag is not given
+	configFileLists map[string][]string
+}
+
+// list returns the comma-separated values of a list flag, or the list of the
+// configuration file setting of the flag if the flag is not given.
+func (config *CheckCommandConfig) list(name string, value string) []string {
+	if value != "" {
+		return strings.Split(value, ",")
+	}
+
+	return config.configFileLists[name]
 }
 
 // CheckCommand is a Command implementation
@@ -78,8 +104,11 @@ func (*CheckCommand) Help() string {
 	fmt.Fprintf(opts, CommandHelpOptionFormat, "-allowed-guide-subcategories-file", "Path to newline separated file of allowed guide frontmatter subcategories.")
 	fmt.Fprintf(opts, CommandHelpOptionFormat, "-allowed-resource-subcategories", "Comma separated list of allowed data source and resource frontmatter subcategories.")
 	fmt.Fprintf(opts, CommandHelpOptionFormat, "-allowed-resource-subcategories-file", "Path to newline separated file of allowed data source and resource frontmatter subcategories.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-baseline", "Path to baseline file of accepted findings. Only findings not in the baseline fail the check and baseline entries which no longer occur are reported.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-config", fmt.Sprintf("Path to YAML configuration file of check settings. Defaults to %s in the provider root directory, if present. Flags override configuration file settings.", CheckConfigFileName))
 	fmt.Fprintf(opts, CommandHelpOptionFormat, "-enable-contents-check", "(Experimental) Enable contents checking.")
 	fmt.Fprintf(opts, CommandHelpOptionFormat, "-enable-enhanced-region-check", "Enable enhanced Region functionality checks (requires -enable-contents-check).")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-format", fmt.Sprintf("Output format of findings. Valid values: %s. Defaults to text.", strings.Join(report.Formats, ", ")))
 	fmt.Fprintf(opts, CommandHelpOptionFormat, "-ignore-cdktf-missing-files", "Ignore checks for missing CDK for Terraform documentation files when iteratively introducing them in large providers.")
 	fmt.Fprintf(opts, CommandHelpOptionFormat, "-ignore-contents-check-data-sources", "Comma separated list of data sources to ignore contents checking.")
 	fmt.Fprintf(opts, CommandHelpOptionFormat, "-ignore-contents-check-actions", "Comma separated list of actions to ignore contents checking.")
@@ -106,12 +135,15 @@ func (*CheckCommand) Help() string {
 	fmt.Fprintf(opts, CommandHelpOptionFormat, "-ignore-file-missing-functions", "Comma separated list of functions to ignore missing files.")
 	fmt.Fprintf(opts, CommandHelpOptionFormat, "-ignore-file-missing-list-resources", "Comma separated list of list resources to ignore missing files.")
 	fmt.Fprintf(opts, CommandHelpOptionFormat, "-ignore-file-missing-resources", "Comma separated list of resources to ignore missing files.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-output", "Path to file for writing findings. Defaults to standard output for machine readable formats.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-parallelism", "Maximum number of documentation files checked concurrently within a directory. Defaults to the number of CPUs.")
 	fmt.Fprintf(opts, CommandHelpOptionFormat, "-provider-name", "Terraform Provider short name (e.g. aws). Automatically determined if -provider-source is given or if current working directory or provided path is prefixed with terraform-provider-*.")
 	fmt.Fprintf(opts, CommandHelpOptionFormat, "-provider-source", "Terraform Provider source address (e.g. registry.terraform.io/hashicorp/aws) for Terraform CLI 0.13 and later -providers-schema-json. Automatically sets -provider-name by dropping hostname and namespace prefix.")
 	fmt.Fprintf(opts, CommandHelpOptionFormat, "-providers-schema-json", "Path to terraform providers schema -json file. Enables enhanced validations.")
 	fmt.Fprintf(opts, CommandHelpOptionFormat, "-require-guide-subcategory", "Require guide frontmatter subcategory.")
 	fmt.Fprintf(opts, CommandHelpOptionFormat, "-require-resource-subcategory", "Require data source and resource frontmatter subcategory.")
 	fmt.Fprintf(opts, CommandHelpOptionFormat, "-require-schema-ordering", "Require schema attribute lists to be alphabetically ordered (requires -enable-contents-check).")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-write-baseline", "Path to write baseline file of all current findings, which can be given to -baseline.")
 	opts.Flush()
 
 	helpText := fmt.Sprintf(`
@@ -135,8 +167,11 @@ func configureCheckCommandFlags(flags *flag.FlagSet, config *CheckCommandConfig)
 	flags.StringVar(&config.AllowedGuideSubcategoriesFile, "allowed-guide-subcategories-file", "", "")
 	flags.StringVar(&config.AllowedResourceSubcategories, "allowed-resource-subcategories", "", "")
 	flags.StringVar(&config.AllowedResourceSubcategoriesFile, "allowed-resource-subcategories-file", "", "")
+	flags.StringVar(&config.Baseline, "baseline", "", "")
+	flags.StringVar(&config.ConfigFile, "config", "", "")
 	flags.BoolVar(&config.EnableContentsCheck, "enable-contents-check", false, "")
 	flags.BoolVar(&config.EnableEnhancedRegionCheck, "enable-enhanced-region-check", false, "")
+	flags.StringVar(&config.Format, "format", report.FormatText, "")
 	flags.BoolVar(&config.IgnoreCdktfMissingFiles, "ignore-cdktf-missing-files", false, "")
 	flags.StringVar(&config.IgnoreContentsCheckDataSources, "ignore-contents-check-data-sources", "", "")
 	flags.StringVar(&config.IgnoreContentsCheckActions, "ignore-contents-check-actions", "", "")
@@ -163,12 +198,15 @@ func configureCheckCommandFlags(flags *flag.FlagSet, config *CheckCommandConfig)
 	flags.StringVar(&config.IgnoreFileMissingFunctions, "ignore-file-missing-functions", "", "")
 	flags.StringVar(&config.IgnoreFileMissingListResources, "ignore-file-missing-list-resources", "", "")
 	flags.StringVar(&config.IgnoreFileMissingResources, "ignore-file-missing-resources", "", "")
+	flags.StringVar(&config.Output, "output", "", "")
+	flags.IntVar(&config.Parallelism, "parallelism", runtime.NumCPU(), "")
 	flags.StringVar(&config.ProviderName, "provider-name", "", "")
 	flags.StringVar(&config.ProviderSource, "provider-source", "", "")
 	flags.StringVar(&config.ProvidersSchemaJson, "providers-schema-json", "", "")
 	flags.BoolVar(&config.RequireGuideSubcategory, "require-guide-subcategory", false, "")
 	flags.BoolVar(&config.RequireResourceSubcategory, "require-resource-subcategory", false, "")
 	flags.BoolVar(&config.RequireSchemaOrdering, "require-schema-ordering", false, "")
+	flags.StringVar(&config.WriteBaseline, "write-baseline", "", "")
 }
 
 func (c *CheckCommand) Run(args []string) int {
@@ -189,8 +227,27 @@ func (c *CheckCommand) Run(args []string) int {
 		config.Path = args[0]
 	}
 
+	if path := checkConfigFilePath(config.ConfigFile, config.Path); path != "" {
+		file, err := LoadCheckConfigFile(path)
+
+		if err != nil {
+			c.Ui.Error(fmt.Sprintf("Error loading configuration file: %s", err))
+			return 1
+		}
+
+		if err := applyCheckConfigFile(flags, &config, file); err != nil {
+			c.Ui.Error(fmt.Sprintf("Error applying configuration file (%s): %s", path, err))
+			return 1
+		}
+	}
+
 	ConfigureLogging(c.Name(), config.LogLevel)
 
+	if !slices.Contains(report.Formats, config.Format) {
+		c.Ui.Error(fmt.Sprintf("Error checking Terraform Provider documentation: unsupported format (%s), should be one of: %s", config.Format, strings.Join(report.Formats, ", ")))
+		return 1
+	}
+
 	if config.ProviderName == "" && config.ProviderSource != "" {
 		providerSourceParts := strings.Split(config.ProviderSource, "/")
 		config.ProviderName = providerSourceParts[len(providerSourceParts)-1]
@@ -227,10 +284,7 @@ func (c *CheckCommand) Run(args []string) int {
 		return 1
 	}
 
-	var allowedGuideSubcategories []string
-	if v := config.AllowedGuideSubcategories; v != "" {
-		allowedGuideSubcategories = strings.Split(v, ",")
-	}
+	allowedGuideSubcategories := config.list("allowed-guide-subcategories", config.AllowedGuideSubcategories)
 
 	if v := config.AllowedGuideSubcategoriesFile; v != "" {
 		var err error
@@ -242,10 +296,7 @@ func (c *CheckCommand) Run(args []string) int {
 		}
 	}
 
-	var allowedResourceSubcategories []string
-	if v := config.AllowedResourceSubcategories; v != "" {
-		allowedResourceSubcategories = strings.Split(v, ",")
-	}
+	allowedResourceSubcategories := config.list("allowed-resource-subcategories", config.AllowedResourceSubcategories)
 
 	if v := config.AllowedResourceSubcategoriesFile; v != "" {
 		var err error
@@ -257,35 +308,13 @@ func (c *CheckCommand) Run(args []string) int {
 		}
 	}
 
-	var ignoreContentsCheckDataSources []string
-	var ignoreContentsCheckActions []string
-	if v := config.IgnoreContentsCheckDataSources; v != "" {
-		ignoreContentsCheckDataSources = strings.Split(v, ",")
-	}
-
-	if v := config.IgnoreContentsCheckActions; v != "" {
-		ignoreContentsCheckActions = strings.Split(v, ",")
-	}
-
-	var ignoreContentsCheckEphemerals []string
-	if v := config.IgnoreContentsCheckEphemerals; v != "" {
-		ignoreContentsCheckEphemerals = strings.Split(v, ",")
-	}
-
-	var ignoreContentsCheckFunctions []string
-	if v := config.IgnoreContentsCheckFunctions; v != "" {
-		ignoreContentsCheckFunctions = strings.Split(v, ",")
-	}
+	ignoreContentsCheckDataSources := config.list("ignore-contents-check-data-sources", config.IgnoreContentsCheckDataSources)
+	ignoreContentsCheckActions := config.list("ignore-contents-check-actions", config.IgnoreContentsCheckActions)
+	ignoreContentsCheckEphemerals := config.list("ignore-contents-check-ephemerals", config.IgnoreContentsCheckEphemerals)
+	ignoreContentsCheckFunctions := config.list("ignore-contents-check-functions", config.IgnoreContentsCheckFunctions)
+	ignoreContentsCheckResources := config.list("ignore-contents-check-resources", config.IgnoreContentsCheckResources)
 
-	var ignoreContentsCheckResources []string
-	if v := config.IgnoreContentsCheckResources; v != "" {
-		ignoreContentsCheckResources = strings.Split(v, ",")
-	}
-
-	var ignoreEnhancedRegionCheckDataSources []string
-	if v := config.IgnoreEnhancedRegionCheckDataSources; v != "" {
-		ignoreEnhancedRegionCheckDataSources = strings.Split(v, ",")
-	}
+	ignoreEnhancedRegionCheckDataSources := config.list("ignore-enhanced-region-check-data-sources", config.IgnoreEnhancedRegionCheckDataSources)
 
 	if v := config.IgnoreEnhancedRegionCheckDataSourcesFile; v != "" {
 		var err error
@@ -297,10 +326,7 @@ func (c *CheckCommand) Run(args []string) int {
 		}
 	}
 
-	var ignoreEnhancedRegionCheckEphemerals []string
-	if v := config.IgnoreEnhancedRegionCheckEphemerals; v != "" {
-		ignoreEnhancedRegionCheckEphemerals = strings.Split(v, ",")
-	}
+	ignoreEnhancedRegionCheckEphemerals := config.list("ignore-enhanced-region-check-ephemerals", config.IgnoreEnhancedRegionCheckEphemerals)
 
 	if v := config.IgnoreEnhancedRegionCheckEphemeralsFile; v != "" {
 		var err error
@@ -312,10 +338,7 @@ func (c *CheckCommand) Run(args []string) int {
 		}
 	}
 
-	var ignoreEnhancedRegionCheckResources []string
-	if v := config.IgnoreEnhancedRegionCheckResources; v != "" {
-		ignoreEnhancedRegionCheckResources = strings.Split(v, ",")
-	}
+	ignoreEnhancedRegionCheckResources := config.list("ignore-enhanced-region-check-resources", config.IgnoreEnhancedRegionCheckResources)
 
 	if v := config.IgnoreEnhancedRegionCheckResourcesFile; v != "" {
 		var err error
@@ -327,10 +350,7 @@ func (c *CheckCommand) Run(args []string) int {
 		}
 	}
 
-	var ignoreEnhancedRegionCheckSubcategories []string
-	if v := config.IgnoreEnhancedRegionCheckSubcategories; v != "" {
-		ignoreEnhancedRegionCheckSubcategories = strings.Split(v, ",")
-	}
+	ignoreEnhancedRegionCheckSubcategories := config.list("ignore-enhanced-region-check-subcategories", config.IgnoreEnhancedRegionCheckSubcategories)
 
 	if v := config.IgnoreEnhancedRegionCheckSubcategoriesFile; v != "" {
 		var err error
@@ -342,66 +362,22 @@ func (c *CheckCommand) Run(args []string) int {
 		}
 	}
 
-	var ignoreFileMismatchDataSources []string
-	var ignoreFileMismatchActions []string
-	if v := config.IgnoreFileMismatchDataSources; v != "" {
-		ignoreFileMismatchDataSources = strings.Split(v, ",")
-	}
+	ignoreFileMismatchDataSources := config.list("ignore-file-mismatch-data-sources", config.IgnoreFileMismatchDataSources)
+	ignoreFileMismatchActions := config.list("ignore-file-mismatch-actions", config.IgnoreFileMismatchActions)
+	ignoreFileMismatchEphemerals := config.list("ignore-file-mismatch-ephemerals", config.IgnoreFileMismatchEphemerals)
+	ignoreFileMismatchFunctions := config.list("ignore-file-mismatch-functions", config.IgnoreFileMismatchFunctions)
+	ignoreFileMismatchListResources := config.list("ignore-file-mismatch-list-resources", config.IgnoreFileMismatchListResources)
+	ignoreFileMismatchResources := config.list("ignore-file-mismatch-resources", config.IgnoreFileMismatchResources)
 
-	if v := config.IgnoreFileMismatchActions; v != "" {
-		ignoreFileMismatchActions = strings.Split(v, ",")
-	}
-
-	var ignoreFileMismatchEphemerals []string
-	if v := config.IgnoreFileMismatchEphemerals; v != "" {
-		ignoreFileMismatchEphemerals = strings.Split(v, ",")
-	}
-
-	var ignoreFileMismatchFunctions []string
-	if v := config.IgnoreFileMismatchFunctions; v != "" {
-		ignoreFileMismatchFunctions = strings.Split(v, ",")
-	}
-
-	var ignoreFileMismatchListResources []string
-	if v := config.IgnoreFileMismatchListResources; v != "" {
-		ignoreFileMismatchListResources = strings.Split(v, ",")
-	}
-
-	var ignoreFileMismatchResources []string
-	if v := config.IgnoreFileMismatchResources; v != "" {
-		ignoreFileMismatchResources = strings.Split(v, ",")
-	}
-
-	var ignoreFileMissingDataSources []string
-	var ignoreFileMissingActions []string
-	if v := config.IgnoreFileMissingDataSources; v != "" {
-		ignoreFileMissingDataSources = strings.Split(v, ",")
-	}
-
-	if v := config.IgnoreFileMissingActions; v != "" {
-		ignoreFileMissingActions = strings.Split(v, ",")
-	}
-	var ignoreFileMissingEphemerals []string
-	if v := config.IgnoreFileMissingEphemerals; v != "" {
-		ignoreFileMissingEphemerals = strings.Split(v, ",")
-	}
-
-	var ignoreFileMissingFunctions []string
-	if v := config.IgnoreFileMissingFunctions; v != "" {
-		ignoreFileMissingFunctions = strings.Split(v, ",")
-	}
-
-	var ignoreFileMissingListResources []string
-	if v := config.IgnoreFileMissingListResources; v != "" {
-		ignoreFileMissingListResources = strings.Split(v, ",")
-	}
-
-	var ignoreFileMissingResources []string
-	if v := config.IgnoreFileMissingResources; v != "" {
-		ignoreFileMissingResources = strings.Split(v, ",")
-	}
+	ignoreFileMissingDataSources := config.list("ignore-file-missing-data-sources", config.IgnoreFileMissingDataSources)
+	ignoreFileMissingActions := config.list("ignore-file-missing-actions", config.IgnoreFileMissingActions)
+	ignoreFileMissingEphemerals := config.list("ignore-file-missing-ephemerals", config.IgnoreFileMissingEphemerals)
+	ignoreFileMissingFunctions := config.list("ignore-file-missing-functions", config.IgnoreFileMissingFunctions)
+	ignoreFileMissingListResources := config.list("ignore-file-missing-list-resources", config.IgnoreFileMissingListResources)
+	ignoreFileMissingResources := config.list("ignore-file-missing-resources", config.IgnoreFileMissingResources)
 
 	var actionNames, dataSourceNames, ephemeralNames, listResourceNames, resourceNames, functionNames []string
+	var actionSchemas, dataSourceSchemas, ephemeralSchemas, listResourceSchemas, resourceSchemas map[string]*tfjson.SchemaBlock
 	if config.ProvidersSchemaJson != "" {
 		ps, err := providerSchemas(config.ProvidersSchemaJson)
 
@@ -424,10 +400,19 @@ Check that the current working directory or provided path is prefixed with terra
 		functionNames = providerSchemasFunctions(ps, config.ProviderName, config.ProviderSource)
 		listResourceNames = providerSchemasListResources(ps, config.ProviderName, config.ProviderSource)
 		resourceNames = providerSchemasResources(ps, config.ProviderName, config.ProviderSource)
+
+		if provider := providerSchema(ps, config.ProviderName, config.ProviderSource); provider != nil {
+			actionSchemas = schemaBlocks(provider.ActionSchemas, actionSchemaBlock)
+			dataSourceSchemas = schemaBlocks(provider.DataSourceSchemas, schemaBlock)
+			ephemeralSchemas = schemaBlocks(provider.EphemeralResourceSchemas, schemaBlock)
+			listResourceSchemas = schemaBlocks(provider.ListResourceSchemas, schemaBlock)
+			resourceSchemas = schemaBlocks(provider.ResourceSchemas, schemaBlock)
+		}
 	}
 
 	fileOpts := &check.FileOptions{
-		BasePath: config.Path,
+		BasePath:    config.Path,
+		Parallelism: config.Parallelism,
 	}
 	checkOpts := &check.CheckOptions{
 		// action
@@ -449,6 +434,7 @@ Check that the current working directory or provided path is prefixed with terra
 					"The following arguments are optional:",
 					"This action does not support any arguments.",
 				},
+				Schemas: actionSchemas,
 			},
 			FileOptions: fileOpts,
 			FrontMatter: &check.FrontMatterOptions{
@@ -475,6 +461,7 @@ Check that the current working directory or provided path is prefixed with terra
 					"The following arguments are optional:",
 					"This action does not support any arguments.",
 				},
+				Schemas: actionSchemas,
 			},
 			FileOptions: fileOpts,
 			FrontMatter: &check.FrontMatterOptions{
@@ -504,6 +491,7 @@ Check that the current working directory or provided path is prefixed with terra
 				IgnoreEnhancedRegionCheckSubcategories: ignoreEnhancedRegionCheckSubcategories,
 				ProviderName:                           config.ProviderName,
 				TitleSectionPrefixes:                   []string{"Data Source"},
+				Schemas:                                dataSourceSchemas,
 			},
 			FileOptions: fileOpts,
 			FrontMatter: &check.FrontMatterOptions{
@@ -523,6 +511,7 @@ Check that the current working directory or provided path is prefixed with terra
 				IgnoreEnhancedRegionCheckSubcategories: ignoreEnhancedRegionCheckSubcategories,
 				ProviderName:                           config.ProviderName,
 				TitleSectionPrefixes:                   []string{"Data Source"},
+				Schemas:                                dataSourceSchemas,
 			},
 			FileOptions: fileOpts,
 			FrontMatter: &check.FrontMatterOptions{
@@ -551,6 +540,7 @@ Check that the current working directory or provided path is prefixed with terra
 				IgnoreEnhancedRegionCheckSubcategories: ignoreEnhancedRegionCheckSubcategories,
 				ProviderName:                           config.ProviderName,
 				TitleSectionPrefixes:                   []string{"Ephemeral"},
+				Schemas:                                ephemeralSchemas,
 			},
 			FileOptions: fileOpts,
 			FrontMatter: &check.FrontMatterOptions{
@@ -571,6 +561,7 @@ Check that the current working directory or provided path is prefixed with terra
 				IgnoreEnhancedRegionCheckSubcategories: ignoreEnhancedRegionCheckSubcategories,
 				ProviderName:                           config.ProviderName,
 				TitleSectionPrefixes:                   []string{"Ephemeral"},
+				Schemas:                                ephemeralSchemas,
 			},
 			FileOptions: fileOpts,
 			FrontMatter: &check.FrontMatterOptions{
@@ -657,6 +648,7 @@ Check that the current working directory or provided path is prefixed with terra
 				IgnoreEnhancedRegionCheckSubcategories: ignoreEnhancedRegionCheckSubcategories,
 				ProviderName:                           config.ProviderName,
 				TitleSectionPrefixes:                   []string{"List Resource"},
+				Schemas:                                listResourceSchemas,
 			},
 			FileOptions: fileOpts,
 			FrontMatter: &check.FrontMatterOptions{
@@ -678,6 +670,7 @@ Check that the current working directory or provided path is prefixed with terra
 				IgnoreEnhancedRegionCheckSubcategories: ignoreEnhancedRegionCheckSubcategories,
 				ProviderName:                           config.ProviderName,
 				TitleSectionPrefixes:                   []string{"List Resource"},
+				Schemas:                                listResourceSchemas,
 			},
 			FileOptions: fileOpts,
 			FrontMatter: &check.FrontMatterOptions{
@@ -707,6 +700,7 @@ Check that the current working directory or provided path is prefixed with terra
 				IgnoreEnhancedRegionCheckSubcategories: ignoreEnhancedRegionCheckSubcategories,
 				ProviderName:                           config.ProviderName,
 				TitleSectionPrefixes:                   []string{"Resource"},
+				Schemas:                                resourceSchemas,
 			},
 			FileOptions: fileOpts,
 			FrontMatter: &check.FrontMatterOptions{
@@ -727,6 +721,7 @@ Check that the current working directory or provided path is prefixed with terra
 				IgnoreEnhancedRegionCheckSubcategories: ignoreEnhancedRegionCheckSubcategories,
 				ProviderName:                           config.ProviderName,
 				TitleSectionPrefixes:                   []string{"Resource"},
+				Schemas:                                resourceSchemas,
 			},
 			FileOptions: fileOpts,
 			FrontMatter: &check.FrontMatterOptions{
@@ -773,14 +768,97 @@ Check that the current working directory or provided path is prefixed with terra
 		IgnoreCdktfMissingFiles: config.IgnoreCdktfMissingFiles,
 	}
 
-	if err := check.NewCheck(checkOpts).Run(directories); err != nil {
-		c.Ui.Error(fmt.Sprintf("Error checking Terraform Provider documentation: %s", err))
+	err = check.NewCheck(checkOpts).Run(directories)
+
+	if config.WriteBaseline != "" {
+		baseline := diagnostic.NewBaseline(diagnostic.FromError(err))
+
+		if err := baseline.WriteFile(config.WriteBaseline); err != nil {
+			c.Ui.Error(fmt.Sprintf("Error writing baseline: %s", err))
+			return 1
+		}
+
+		c.Ui.Info(fmt.Sprintf("Wrote %d baseline entries to: %s", len(baseline.Entries), config.WriteBaseline))
+
+		return 0
+	}
+
+	if config.Baseline != "" {
+		baseline, berr := diagnostic.ReadBaseline(config.Baseline)
+
+		if berr != nil {
+			c.Ui.Error(fmt.Sprintf("Error reading baseline: %s", berr))
+			return 1
+		}
+
+		var stale []*diagnostic.BaselineEntry
+
+		stale, err = filterBaseline(baseline, err)
+
+		for _, entry := range stale {
+			c.Ui.Warn(fmt.Sprintf("Baseline entry no longer occurs (%d): %s: %s (%s)", entry.Count, entry.Path, entry.Message, entry.Rule))
+		}
+	}
+
+	if config.Format == report.FormatText && config.Output == "" {
+		if err != nil {
+			c.Ui.Error(fmt.Sprintf("Error checking Terraform Provider documentation: %s", err))
+			return 1
+		}
+
+		return 0
+	}
+
+	rules := slices.Concat(check.Rules, contents.Rules)
+
+	if err := c.writeReport(report.New(err, directories, rules, version.GetVersion().FullVersionNumber(false)), config.Format, config.Output); err != nil {
+		c.Ui.Error(fmt.Sprintf("Error writing Terraform Provider documentation report: %s", err))
+		return 1
+	}
+
+	if err != nil {
 		return 1
 	}
 
 	return 0
 }
 
+// filterBaseline returns the baseline entries which no longer occur and the
+// findings of err not accepted by the baseline.
+func filterBaseline(baseline *diagnostic.Baseline, err error) ([]*diagnostic.BaselineEntry, error) {
+	diagnostics, stale := baseline.Filter(diagnostic.FromError(err))
+
+	var result *multierror.Error
+
+	for _, d := range diagnostics {
+		result = multierror.Append(result, d)
+	}
+
+	return stale, result.ErrorOrNil()
+}
+
+// writeReport writes the report in the format to the output file path, or
+// standard output if the path is empty.
+func (c *CheckCommand) writeReport(r *report.Report, format string, output string) error {
+	var buf bytes.Buffer
+
+	if err := r.Write(&buf, format); err != nil {
+		return err
+	}
+
+	if output == "" {
+		c.Ui.Output(strings.TrimSuffix(buf.String(), "\n"))
+
+		return nil
+	}
+
+	if err := os.WriteFile(output, buf.Bytes(), 0644); err != nil {
+		return fmt.Errorf("error writing output file (%s): %w", output, err)
+	}
+
+	return nil
+}
+
 func (c *CheckCommand) Synopsis() string {
 	return "Checks Terraform Provider documentation"
 }
@@ -852,8 +930,8 @@ func providerSchemas(path string) (*tfjson.ProviderSchemas, error) {
 	return &ps, nil
 }
 
-// providerSchemasDataSources returns all data source names from a terraform providers schema -json provider.
-func providerSchemasDataSources(ps *tfjson.ProviderSchemas, providerName string, providerSource string) []string {
+// providerSchema returns the provider schema matching the provider source or name.
+func providerSchema(ps *tfjson.ProviderSchemas, providerName string, providerSource string) *tfjson.ProviderSchema {
 	if ps == nil || ps.Schemas == nil {
 		return nil
 	}
@@ -869,6 +947,49 @@ func providerSchemasDataSources(ps *tfjson.ProviderSchemas, providerName string,
 		return nil
 	}
 
+	return provider
+}
+
+// schemaBlocks returns the root schema block of each schema keyed by name,
+// omitting schemas without a block.
+func schemaBlocks[S any](schemas map[string]S, block func(S) *tfjson.SchemaBlock) map[string]*tfjson.SchemaBlock {
+	blocks := make(map[string]*tfjson.SchemaBlock, len(schemas))
+
+	for name, schema := range schemas {
+		if b := block(schema); b != nil {
+			blocks[name] = b
+		}
+	}
+
+	return blocks
+}
+
+// schemaBlock returns the root schema block of a schema, if any.
+func schemaBlock(schema *tfjson.Schema) *tfjson.SchemaBlock {
+	if schema == nil {
+		return nil
+	}
+
+	return schema.Block
+}
+
+// actionSchemaBlock returns the root schema block of an action schema, if any.
+func actionSchemaBlock(schema *tfjson.ActionSchema) *tfjson.SchemaBlock {
+	if schema == nil {
+		return nil
+	}
+
+	return schema.Block
+}
+
+// providerSchemasDataSources returns all data source names from a terraform providers schema -json provider.
+func providerSchemasDataSources(ps *tfjson.ProviderSchemas, providerName string, providerSource string) []string {
+	provider := providerSchema(ps, providerName, providerSource)
+
+	if provider == nil {
+		return nil
+	}
+
 	dataSources := make([]string, 0, len(provider.DataSourceSchemas))
 
 	for name := range provider.DataSourceSchemas {
@@ -884,18 +1005,9 @@ func providerSchemasDataSources(ps *tfjson.ProviderSchemas, providerName string,
 
 // providerSchemasActions returns all action names from a terraform providers schema -json provider.
 func providerSchemasActions(ps *tfjson.ProviderSchemas, providerName string, providerSource string) []string {
-	if ps == nil || ps.Schemas == nil {
-		return nil
-	}
-
-	provider, ok := ps.Schemas[providerSource]
+	provider := providerSchema(ps, providerName, providerSource)
 
-	if !ok {
-		provider, ok = ps.Schemas[providerName]
-	}
-
-	if !ok {
-		log.Printf("[WARN] Provider source (%s) and name (%s) not found in provider schema", providerSource, providerName)
+	if provider == nil {
 		return nil
 	}
 
@@ -914,18 +1026,9 @@ func providerSchemasActions(ps *tfjson.ProviderSchemas, providerName string, pro
 
 // providerSchemasEphemerals returns all ephemeral names from a terraform providers schema -json provider.
 func providerSchemasEphemerals(ps *tfjson.ProviderSchemas, providerName string, providerSource string) []string {
-	if ps == nil || ps.Schemas == nil {
-		return nil
-	}
-
-	provider, ok := ps.Schemas[providerSource]
+	provider := providerSchema(ps, providerName, providerSource)
 
-	if !ok {
-		provider, ok = ps.Schemas[providerName]
-	}
-
-	if !ok {
-		log.Printf("[WARN] Provider source (%s) and name (%s) not found in provider schema", providerSource, providerName)
+	if provider == nil {
 		return nil
 	}
 
@@ -944,18 +1047,9 @@ func providerSchemasEphemerals(ps *tfjson.ProviderSchemas, providerName string,
 
 // providerSchemasFunctions returns all function names from a terraform providers schema -json provider.
 func providerSchemasFunctions(ps *tfjson.ProviderSchemas, providerName string, providerSource string) []string {
-	if ps == nil || ps.Schemas == nil {
-		return nil
-	}
+	provider := providerSchema(ps, providerName, providerSource)
 
-	provider, ok := ps.Schemas[providerSource]
-
-	if !ok {
-		provider, ok = ps.Schemas[providerName]
-	}
-
-	if !ok {
-		log.Printf("[WARN] Provider source (%s) and name (%s) not found in provider schema", providerSource, providerName)
+	if provider == nil {
 		return nil
 	}
 
@@ -974,18 +1068,9 @@ func providerSchemasFunctions(ps *tfjson.ProviderSchemas, providerName string, p
 
 // providerSchemasListResources returns all list resource names from a terraform providers schema -json provider.
 func providerSchemasListResources(ps *tfjson.ProviderSchemas, providerName string, providerSource string) []string {
-	if ps == nil || ps.Schemas == nil {
-		return nil
-	}
+	provider := providerSchema(ps, providerName, providerSource)
 
-	provider, ok := ps.Schemas[providerSource]
-
-	if !ok {
-		provider, ok = ps.Schemas[providerName]
-	}
-
-	if !ok {
-		log.Printf("[WARN] Provider source (%s) and name (%s) not found in provider schema", providerSource, providerName)
+	if provider == nil {
 		return nil
 	}
 
@@ -1004,18 +1089,9 @@ func providerSchemasListResources(ps *tfjson.ProviderSchemas, providerName strin
 
 // providerSchemasResources returns all resource names from a terraform providers schema -json provider.
 func providerSchemasResources(ps *tfjson.ProviderSchemas, providerName string, providerSource string) []string {
-	if ps == nil || ps.Schemas == nil {
-		return nil
-	}
+	provider := providerSchema(ps, providerName, providerSource)
 
-	provider, ok := ps.Schemas[providerSource]
-
-	if !ok {
-		provider, ok = ps.Schemas[providerName]
-	}
-
-	if !ok {
-		log.Printf("[WARN] Provider source (%s) and name (%s) not found in provider schema", providerSource, providerName)
+	if provider == nil {
 		return nil
 	}
 
@@ -0,0 +1,297 @@
+// Copyright IBM Corp. 2019, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package command
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	// CheckConfigFileName is the name of the configuration file loaded from
+	// the provider root directory.
+	CheckConfigFileName = ".tfproviderdocs.yml"
+)
+
+// CheckConfigFile represents the check command configuration file.
+//
+// Each setting is equivalent to a check command flag. Flags given on the
+// command line override settings of the file.
+type CheckConfigFile struct {
+	Baseline                string                              `yaml:"baseline"`
+	EnableContentsCheck     bool                                `yaml:"enable_contents_check"`
+	EnhancedRegionCheck     *CheckConfigFileEnhancedRegionCheck `yaml:"enhanced_region_check"`
+	Format                  string                              `yaml:"format"`
+	IgnoreCdktfMissingFiles bool                                `yaml:"ignore_cdktf_missing_files"`
+	LogLevel                string                              `yaml:"log_level"`
+	Output                  string                              `yaml:"output"`
+	Parallelism             int                                 `yaml:"parallelism"`
+	ProviderName            string                              `yaml:"provider_name"`
+	ProviderSource          string                              `yaml:"provider_source"`
+	ProvidersSchemaJson     string                              `yaml:"providers_schema_json"`
+	RequireSchemaOrdering   bool                                `yaml:"require_schema_ordering"`
+
+	Actions       *CheckConfigFileKind `yaml:"actions"`
+	DataSources   *CheckConfigFileKind `yaml:"data_sources"`
+	Ephemerals    *CheckConfigFileKind `yaml:"ephemerals"`
+	Functions     *CheckConfigFileKind `yaml:"functions"`
+	Guides        *CheckConfigFileKind `yaml:"guides"`
+	ListResources *CheckConfigFileKind `yaml:"list_resources"`
+	Resources     *CheckConfigFileKind `yaml:"resources"`
+}
+
+// CheckConfigFileEnhancedRegionCheck represents the enhanced Region check
+// settings of the check command configuration file.
+type CheckConfigFileEnhancedRegionCheck struct {
+	Enabled                 bool     `yaml:"enabled"`
+	IgnoreSubcategories     []string `yaml:"ignore_subcategories"`
+	IgnoreSubcategoriesFile string   `yaml:"ignore_subcategories_file"`
+}
+
+// CheckConfigFileKind represents the settings of a documentation kind in the
+// check command configuration file.
+//
+// Not every setting is supported by every kind, e.g. allowed subcategories
+// are only supported for guides and resources (which includes data sources).
+type CheckConfigFileKind struct {
+	AllowedSubcategories          []string `yaml:"allowed_subcategories"`
+	AllowedSubcategoriesFile      string   `yaml:"allowed_subcategories_file"`
+	IgnoreContentsCheck           []string `yaml:"ignore_contents_check"`
+	IgnoreEnhancedRegionCheck     []string `yaml:"ignore_enhanced_region_check"`
+	IgnoreEnhancedRegionCheckFile string   `yaml:"ignore_enhanced_region_check_file"`
+	IgnoreFileMismatch            []string `yaml:"ignore_file_mismatch"`
+	IgnoreFileMissing             []string `yaml:"ignore_file_missing"`
+	RequireSubcategory            bool     `yaml:"require_subcategory"`
+}
+
+// checkConfigFileFlagValues holds check command flag values keyed by flag name.
+type checkConfigFileFlagValues map[string]string
+
+func (values checkConfigFileFlagValues) bool(name string, value bool) {
+	if value {
+		values[name] = "true"
+	}
+}
+
+func (values checkConfigFileFlagValues) int(name string, value int) {
+	if value != 0 {
+		values[name] = strconv.Itoa(value)
+	}
+}
+
+func (values checkConfigFileFlagValues) string(name string, value string) {
+	if value != "" {
+		values[name] = value
+	}
+}
+
+// checkConfigFileFlagLists holds check command list flag values keyed by flag
+// name. Lists are kept as is rather than joined into a comma-separated flag
+// value, so entries may contain commas (e.g. re:a{1,3} patterns).
+type checkConfigFileFlagLists map[string][]string
+
+func (lists checkConfigFileFlagLists) list(name string, value []string) {
+	if len(value) > 0 {
+		lists[name] = value
+	}
+}
+
+// LoadCheckConfigFile reads and parses a check command configuration file.
+func LoadCheckConfigFile(path string) (*CheckConfigFile, error) {
+	content, err := os.ReadFile(path)
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading configuration file (%s): %w", path, err)
+	}
+
+	var result CheckConfigFile
+
+	if err := yaml.UnmarshalStrict(content, &result); err != nil {
+		return nil, fmt.Errorf("error parsing configuration file (%s): %w", path, err)
+	}
+
+	result.resolvePaths(filepath.Dir(path))
+
+	return &result, nil
+}
+
+// resolvePaths resolves relative file path settings against the directory of
+// the configuration file, rather than the current working directory.
+func (file *CheckConfigFile) resolvePaths(dir string) {
+	resolve := func(path *string) {
+		if *path != "" && !filepath.IsAbs(*path) {
+			*path = filepath.Join(dir, *path)
+		}
+	}
+
+	resolve(&file.Baseline)
+	resolve(&file.Output)
+	resolve(&file.ProvidersSchemaJson)
+
+	if v := file.EnhancedRegionCheck; v != nil {
+		resolve(&v.IgnoreSubcategoriesFile)
+	}
+
+	for _, kind := range []*CheckConfigFileKind{file.Actions, file.DataSources, file.Ephemerals, file.Functions, file.Guides, file.ListResources, file.Resources} {
+		if kind == nil {
+			continue
+		}
+
+		resolve(&kind.AllowedSubcategoriesFile)
+		resolve(&kind.IgnoreEnhancedRegionCheckFile)
+	}
+}
+
+// flagValues returns the check command flag values and list flag values of
+// the configuration file settings, keyed by flag name. An error is returned
+// for kind settings without an equivalent flag in the flag set.
+func (file *CheckConfigFile) flagValues(flags *flag.FlagSet) (checkConfigFileFlagValues, checkConfigFileFlagLists, error) {
+	values := make(checkConfigFileFlagValues)
+	lists := make(checkConfigFileFlagLists)
+
+	values.string("baseline", file.Baseline)
+	values.bool("enable-contents-check", file.EnableContentsCheck)
+	values.string("format", file.Format)
+	values.bool("ignore-cdktf-missing-files", file.IgnoreCdktfMissingFiles)
+	values.string("log-level", file.LogLevel)
+	values.string("output", file.Output)
+	values.int("parallelism", file.Parallelism)
+	values.string("provider-name", file.ProviderName)
+	values.string("provider-source", file.ProviderSource)
+	values.string("providers-schema-json", file.ProvidersSchemaJson)
+	values.bool("require-schema-ordering", file.RequireSchemaOrdering)
+
+	if v := file.EnhancedRegionCheck; v != nil {
+		values.bool("enable-enhanced-region-check", v.Enabled)
+		lists.list("ignore-enhanced-region-check-subcategories", v.IgnoreSubcategories)
+		values.string("ignore-enhanced-region-check-subcategories-file", v.IgnoreSubcategoriesFile)
+	}
+
+	kinds := []struct {
+		key         string
+		kind        *CheckConfigFileKind
+		flagSuffix  string
+		subcategory string
+	}{
+		{key: "actions", kind: file.Actions, flagSuffix: "actions", subcategory: "action"},
+		{key: "data_sources", kind: file.DataSources, flagSuffix: "data-sources", subcategory: "data-source"},
+		{key: "ephemerals", kind: file.Ephemerals, flagSuffix: "ephemerals", subcategory: "ephemeral"},
+		{key: "functions", kind: file.Functions, flagSuffix: "functions", subcategory: "function"},
+		{key: "guides", kind: file.Guides, flagSuffix: "guides", subcategory: "guide"},
+		{key: "list_resources", kind: file.ListResources, flagSuffix: "list-resources", subcategory: "list-resource"},
+		{key: "resources", kind: file.Resources, flagSuffix: "resources", subcategory: "resource"},
+	}
+
+	var errs []error
+
+	for _, v := range kinds {
+		if v.kind == nil {
+			continue
+		}
+
+		kindValues := make(checkConfigFileFlagValues)
+		kindLists := make(checkConfigFileFlagLists)
+
+		kindLists.list(fmt.Sprintf("allowed-%s-subcategories", v.subcategory), v.kind.AllowedSubcategories)
+		kindValues.string(fmt.Sprintf("allowed-%s-subcategories-file", v.subcategory), v.kind.AllowedSubcategoriesFile)
+		kindLists.list("ignore-contents-check-"+v.flagSuffix, v.kind.IgnoreContentsCheck)
+		kindLists.list("ignore-enhanced-region-check-"+v.flagSuffix, v.kind.IgnoreEnhancedRegionCheck)
+		kindValues.string(fmt.Sprintf("ignore-enhanced-region-check-%s-file", v.flagSuffix), v.kind.IgnoreEnhancedRegionCheckFile)
+		kindLists.list("ignore-file-mismatch-"+v.flagSuffix, v.kind.IgnoreFileMismatch)
+		kindLists.list("ignore-file-missing-"+v.flagSuffix, v.kind.IgnoreFileMissing)
+		kindValues.bool(fmt.Sprintf("require-%s-subcategory", v.subcategory), v.kind.RequireSubcategory)
+
+		for _, name := range slices.Sorted(maps.Keys(kindValues)) {
+			if flags.Lookup(name) == nil {
+				errs = append(errs, fmt.Errorf("%s: unsupported setting (no -%s flag)", v.key, name))
+				continue
+			}
+
+			values[name] = kindValues[name]
+		}
+
+		for _, name := range slices.Sorted(maps.Keys(kindLists)) {
+			if flags.Lookup(name) == nil {
+				errs = append(errs, fmt.Errorf("%s: unsupported setting (no -%s flag)", v.key, name))
+				continue
+			}
+
+			lists[name] = kindLists[name]
+		}
+	}
+
+	return values, lists, errors.Join(errs...)
+}
+
+// applyCheckConfigFile sets the flags of the configuration file settings,
+// except flags already given on the command line. List settings are set on
+// the command configuration as is, rather than through their flags.
+func applyCheckConfigFile(flags *flag.FlagSet, config *CheckCommandConfig, file *CheckConfigFile) error {
+	values, lists, err := file.flagValues(flags)
+
+	if err != nil {
+		return err
+	}
+
+	set := make(map[string]bool)
+	flags.Visit(func(f *flag.Flag) {
+		set[f.Name] = true
+	})
+
+	for name, value := range values {
+		if set[name] {
+			continue
+		}
+
+		// A list flag given on the command line overrides both the list and
+		// the list file settings of the configuration file.
+		if listName, ok := strings.CutSuffix(name, "-file"); ok && set[listName] {
+			continue
+		}
+
+		if err := flags.Set(name, value); err != nil {
+			return fmt.Errorf("error setting %s: %w", name, err)
+		}
+	}
+
+	for name, value := range lists {
+		if set[name] {
+			continue
+		}
+
+		if config.configFileLists == nil {
+			config.configFileLists = make(map[string][]string)
+		}
+
+		config.configFileLists[name] = value
+	}
+
+	return nil
+}
+
+// checkConfigFilePath returns the configuration file path. The path flag
+// value is returned if given, otherwise the default file name in the provider
+// root directory is returned if the file exists.
+func checkConfigFilePath(configPath string, providerPath string) string {
+	if configPath != "" {
+		return configPath
+	}
+
+	path := filepath.Join(providerPath, CheckConfigFileName)
+
+	if _, err := os.Stat(path); err != nil {
+		return ""
+	}
+
+	return path
+}
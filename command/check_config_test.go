@@ -0,0 +1,180 @@
+// Copyright IBM Corp. 2019, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package command
+
+import (
+	"flag"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+func TestLoadCheckConfigFile(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Path        string
+		ExpectError bool
+	}{
+		{
+			Name: "valid",
+			Path: "testdata/tfproviderdocs.yml",
+		},
+		{
+			Name:        "unknown setting",
+			Path:        "testdata/tfproviderdocs-unknown.yml",
+			ExpectError: true,
+		},
+		{
+			Name:        "invalid path",
+			Path:        "testdata/does-not-exist.yml",
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			_, got := LoadCheckConfigFile(testCase.Path)
+
+			if got == nil && testCase.ExpectError {
+				t.Errorf("expected error, got no error")
+			}
+
+			if got != nil && !testCase.ExpectError {
+				t.Errorf("expected no error, got error: %s", got)
+			}
+		})
+	}
+}
+
+func TestApplyCheckConfigFile(t *testing.T) {
+	var config CheckCommandConfig
+	flags := flag.NewFlagSet("check", flag.ContinueOnError)
+
+	configureCheckCommandFlags(flags, &config)
+
+	if err := flags.Parse([]string{"-format=sarif", "-ignore-file-missing-resources=test_cli"}); err != nil {
+		t.Fatalf("unexpected error parsing flags: %s", err)
+	}
+
+	file, err := LoadCheckConfigFile("testdata/tfproviderdocs.yml")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := applyCheckConfigFile(flags, &config, file); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !config.EnableContentsCheck || !config.EnableEnhancedRegionCheck || !config.RequireSchemaOrdering {
+		t.Errorf("expected toggles from configuration file to be enabled, got: %#v", config)
+	}
+
+	if got, want := config.Format, "sarif"; got != want {
+		t.Errorf("expected flag to override configuration file Format %q, got %q", want, got)
+	}
+
+	if got, want := config.IgnoreFileMissingResources, "test_cli"; got != want {
+		t.Errorf("expected flag to override configuration file IgnoreFileMissingResources %q, got %q", want, got)
+	}
+
+	if got, want := config.list("ignore-file-missing-resources", config.IgnoreFileMissingResources), []string{"test_cli"}; !slices.Equal(got, want) {
+		t.Errorf("expected flag to override configuration file ignore-file-missing-resources %q, got %q", want, got)
+	}
+
+	if got, want := config.list("allowed-resource-subcategories", config.AllowedResourceSubcategories), []string{"Example Subcategory 1", "Example Subcategory 2"}; !slices.Equal(got, want) {
+		t.Errorf("expected allowed-resource-subcategories %q, got %q", want, got)
+	}
+
+	if got, want := config.list("ignore-contents-check-resources", config.IgnoreContentsCheckResources), []string{"re:test_a{1,3}"}; !slices.Equal(got, want) {
+		t.Errorf("expected ignore-contents-check-resources %q, got %q", want, got)
+	}
+
+	if got, want := config.list("ignore-enhanced-region-check-subcategories", config.IgnoreEnhancedRegionCheckSubcategories), []string{"Example Subcategory"}; !slices.Equal(got, want) {
+		t.Errorf("expected ignore-enhanced-region-check-subcategories %q, got %q", want, got)
+	}
+
+	if got, want := config.list("ignore-file-mismatch-list-resources", config.IgnoreFileMismatchListResources), []string{"test_list"}; !slices.Equal(got, want) {
+		t.Errorf("expected ignore-file-mismatch-list-resources %q, got %q", want, got)
+	}
+
+	if got, want := config.ProvidersSchemaJson, filepath.Join("testdata", "schema.json"); got != want {
+		t.Errorf("expected ProvidersSchemaJson relative to configuration file %q, got %q", want, got)
+	}
+
+	if got, want := config.Parallelism, 2; got != want {
+		t.Errorf("expected Parallelism %d, got %d", want, got)
+	}
+
+	if got, want := config.ProviderName, "test"; got != want {
+		t.Errorf("expected ProviderName %q, got %q", want, got)
+	}
+}
+
+func TestApplyCheckConfigFileUnsupported(t *testing.T) {
+	var config CheckCommandConfig
+	flags := flag.NewFlagSet("check", flag.ContinueOnError)
+
+	configureCheckCommandFlags(flags, &config)
+
+	file, err := LoadCheckConfigFile("testdata/tfproviderdocs-unsupported.yml")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := applyCheckConfigFile(flags, &config, file); err == nil {
+		t.Errorf("expected error, got no error")
+	}
+}
+
+func TestApplyCheckConfigFileListFileOverride(t *testing.T) {
+	testCases := []struct {
+		Name                string
+		Args                []string
+		ExpectFile          string
+		ExpectSubcategories []string
+	}{
+		{
+			Name:       "configuration file",
+			ExpectFile: filepath.Join("testdata", "allowed-subcategories.txt"),
+		},
+		{
+			Name:                "list flag",
+			Args:                []string{"-allowed-resource-subcategories=Flag Subcategory"},
+			ExpectSubcategories: []string{"Flag Subcategory"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			var config CheckCommandConfig
+			flags := flag.NewFlagSet("check", flag.ContinueOnError)
+
+			configureCheckCommandFlags(flags, &config)
+
+			if err := flags.Parse(testCase.Args); err != nil {
+				t.Fatalf("unexpected error parsing flags: %s", err)
+			}
+
+			file, err := LoadCheckConfigFile("testdata/tfproviderdocs-files.yml")
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if err := applyCheckConfigFile(flags, &config, file); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := config.AllowedResourceSubcategoriesFile, testCase.ExpectFile; got != want {
+				t.Errorf("expected AllowedResourceSubcategoriesFile %q, got %q", want, got)
+			}
+
+			if got, want := config.list("allowed-resource-subcategories", config.AllowedResourceSubcategories), testCase.ExpectSubcategories; !slices.Equal(got, want) {
+				t.Errorf("expected allowed-resource-subcategories %q, got %q", want, got)
+			}
+		})
+	}
+}
@@ -8,6 +8,8 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/YakDriver/tfproviderdocs/check/diagnostic"
+	"github.com/hashicorp/go-multierror"
 	tfjson "github.com/hashicorp/terraform-json"
 )
 
@@ -795,3 +797,72 @@ func TestProviderSchemasResources(t *testing.T) {
 		})
 	}
 }
+
+func TestSchemaBlocks(t *testing.T) {
+	block := &tfjson.SchemaBlock{
+		Attributes: map[string]*tfjson.SchemaAttribute{
+			"name": {Required: true},
+		},
+	}
+
+	testCases := []struct {
+		Name    string
+		Schemas map[string]*tfjson.Schema
+		Expect  map[string]*tfjson.SchemaBlock
+	}{
+		{
+			Name:    "no schemas",
+			Schemas: nil,
+			Expect:  map[string]*tfjson.SchemaBlock{},
+		},
+		{
+			Name: "schemas",
+			Schemas: map[string]*tfjson.Schema{
+				"test_resource1": {Block: block},
+				"test_resource2": {},
+			},
+			Expect: map[string]*tfjson.SchemaBlock{
+				"test_resource1": block,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			want := testCase.Expect
+			got := schemaBlocks(testCase.Schemas, schemaBlock)
+
+			if !reflect.DeepEqual(want, got) {
+				t.Errorf("mismatch:\n\nwant:\n\n%v\n\ngot:\n\n%v\n\n", want, got)
+			}
+		})
+	}
+}
+
+func TestFilterBaseline(t *testing.T) {
+	accepted := &diagnostic.Diagnostic{Message: "YAML frontmatter should not contain layout", Path: "docs/resources/one.md", Rule: "frontmatter"}
+	removed := &diagnostic.Diagnostic{Message: "YAML frontmatter should not contain layout", Path: "docs/resources/two.md", Rule: "frontmatter"}
+	added := &diagnostic.Diagnostic{Message: "YAML frontmatter should not contain layout", Path: "docs/resources/three.md", Rule: "frontmatter"}
+
+	baseline := diagnostic.NewBaseline([]*diagnostic.Diagnostic{accepted, removed})
+
+	stale, err := filterBaseline(baseline, multierror.Append(nil, accepted, added))
+
+	if got := diagnostic.FromError(err); len(got) != 1 || got[0] != added {
+		t.Errorf("expected only added finding, got %v", got)
+	}
+
+	if len(stale) != 1 || stale[0].Path != "docs/resources/two.md" {
+		t.Errorf("expected stale docs/resources/two.md entry, got %v", stale)
+	}
+
+	stale, err = filterBaseline(baseline, accepted)
+
+	if err != nil {
+		t.Errorf("expected no error, got error: %s", err)
+	}
+
+	if len(stale) != 1 {
+		t.Errorf("expected 1 stale entry, got %d", len(stale))
+	}
+}
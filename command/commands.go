@@ -17,6 +17,21 @@ func Commands(ui cli.Ui) map[string]cli.CommandFactory {
 				Ui: ui,
 			}, nil
 		},
+		"fix": func() (cli.Command, error) {
+			return &FixCommand{
+				Ui: ui,
+			}, nil
+		},
+		"migrate": func() (cli.Command, error) {
+			return &MigrateCommand{
+				Ui: ui,
+			}, nil
+		},
+		"scaffold": func() (cli.Command, error) {
+			return &ScaffoldCommand{
+				Ui: ui,
+			}, nil
+		},
 		"version": func() (cli.Command, error) {
 			return &VersionCommand{
 				Version: version.GetVersion(),
@@ -0,0 +1,225 @@
+// Copyright IBM Corp. 2019, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package command
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/YakDriver/tfproviderdocs/check"
+	"github.com/YakDriver/tfproviderdocs/fix"
+	"github.com/mitchellh/cli"
+)
+
+type FixCommandConfig struct {
+	Diff                  bool
+	LogLevel              string
+	Path                  string
+	ProviderName          string
+	ProviderSource        string
+	RequireSchemaOrdering bool
+}
+
+// FixCommand is a Command implementation
+type FixCommand struct {
+	Ui cli.Ui
+}
+
+func (*FixCommand) Help() string {
+	optsBuffer := bytes.NewBuffer([]byte{})
+	opts := tabwriter.NewWriter(optsBuffer, 0, 0, 1, ' ', 0)
+	LogLevelFlagHelp(opts)
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-diff", "Print a unified diff of the fixes instead of rewriting documentation files.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-provider-name", "Terraform Provider short name (e.g. aws). Automatically determined if -provider-source is given or if current working directory or provided path is prefixed with terraform-provider-*.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-provider-source", "Terraform Provider source address (e.g. registry.terraform.io/hashicorp/aws). Automatically sets -provider-name by dropping hostname and namespace prefix.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-require-schema-ordering", "Sort argument and attribute lists by name.")
+	opts.Flush()
+
+	helpText := fmt.Sprintf(`
+Usage: tfproviderdocs fix [options] [PATH]
+
+  Rewrites documentation files of the given Terraform Provider codebase to correct findings which have a single safe correction.
+
+Options:
+
+%s
+`, optsBuffer.String())
+
+	return strings.TrimSpace(helpText)
+}
+
+func (c *FixCommand) Name() string { return "fix" }
+
+func (c *FixCommand) Run(args []string) int {
+	var config FixCommandConfig
+
+	flags := flag.NewFlagSet(c.Name(), flag.ContinueOnError)
+	flags.Usage = func() { c.Ui.Info(c.Help()) }
+	LogLevelFlag(flags, &config.LogLevel)
+	flags.BoolVar(&config.Diff, "diff", false, "")
+	flags.StringVar(&config.ProviderName, "provider-name", "", "")
+	flags.StringVar(&config.ProviderSource, "provider-source", "", "")
+	flags.BoolVar(&config.RequireSchemaOrdering, "require-schema-ordering", false, "")
+
+	if err := flags.Parse(args); err != nil {
+		flags.Usage()
+		return 1
+	}
+
+	args = flags.Args()
+
+	if len(args) == 1 {
+		config.Path = args[0]
+	}
+
+	ConfigureLogging(c.Name(), config.LogLevel)
+
+	if config.ProviderName == "" && config.ProviderSource != "" {
+		providerSourceParts := strings.Split(config.ProviderSource, "/")
+		config.ProviderName = providerSourceParts[len(providerSourceParts)-1]
+	}
+
+	if config.ProviderName == "" {
+		if config.Path == "" {
+			config.ProviderName = providerNameFromCurrentDirectory()
+		} else {
+			config.ProviderName = providerNameFromPath(config.Path)
+		}
+	}
+
+	if config.ProviderName == "" {
+		c.Ui.Error("Error fixing Terraform Provider documentation: unknown provider name, use -provider-name or -provider-source")
+		return 1
+	}
+
+	directories, err := check.GetDirectories(config.Path)
+
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error getting Terraform Provider documentation directories: %s", err))
+		return 1
+	}
+
+	var fixed int
+
+	for _, directory := range slices.Sorted(maps.Keys(directories)) {
+		opts := fixOptions(directory, config)
+
+		if opts == nil {
+			continue
+		}
+
+		for _, path := range directories[directory] {
+			fullpath := filepath.Join(config.Path, path)
+			info, err := os.Stat(fullpath)
+
+			if err != nil {
+				c.Ui.Error(fmt.Sprintf("Error reading documentation file (%s): %s", path, err))
+				return 1
+			}
+
+			if info.IsDir() {
+				continue
+			}
+
+			source, err := os.ReadFile(fullpath)
+
+			if err != nil {
+				c.Ui.Error(fmt.Sprintf("Error reading documentation file (%s): %s", path, err))
+				return 1
+			}
+
+			edits, err := fix.Edits(fullpath, source, opts)
+
+			if err != nil {
+				c.Ui.Error(fmt.Sprintf("Error fixing documentation file (%s): %s", path, err))
+				return 1
+			}
+
+			if len(edits) == 0 {
+				continue
+			}
+
+			result := fix.Apply(source, edits)
+
+			if bytes.Equal(source, result) {
+				continue
+			}
+
+			fixed++
+
+			if config.Diff {
+				c.Ui.Output(strings.TrimSuffix(fix.Diff(filepath.ToSlash(path), source, result), "\n"))
+				continue
+			}
+
+			if err := os.WriteFile(fullpath, result, info.Mode().Perm()); err != nil {
+				c.Ui.Error(fmt.Sprintf("Error writing documentation file (%s): %s", path, err))
+				return 1
+			}
+
+			c.Ui.Info(fmt.Sprintf("Fixed: %s (%s)", path, strings.Join(fix.Rules(edits), ", ")))
+		}
+	}
+
+	if fixed == 0 {
+		c.Ui.Info("No fixable documentation issues found")
+	}
+
+	return 0
+}
+
+func (c *FixCommand) Synopsis() string {
+	return "Fixes mechanical Terraform Provider documentation issues"
+}
+
+// fixOptions returns the fix options of documentation files in the directory,
+// or nil if the directory is not fixed. Contents are only fixed in directories
+// of resources, data sources, and similar kinds.
+func fixOptions(directory string, config FixCommandConfig) *fix.Options {
+	directory = filepath.ToSlash(directory)
+	opts := &fix.Options{
+		ProviderName:          config.ProviderName,
+		RequireSchemaOrdering: config.RequireSchemaOrdering,
+	}
+
+	legacy := check.IsValidLegacyDirectory(directory)
+
+	switch {
+	case check.IsValidRegistryDirectory(directory):
+		opts.ForbiddenFrontMatterKeys = []string{"layout", "sidebar_current"}
+	case legacy:
+		opts.ForbiddenFrontMatterKeys = []string{"sidebar_current"}
+	default:
+		return nil
+	}
+
+	for _, kind := range check.Kinds {
+		kindDirectory := check.RegistryIndexDirectory + "/" + kind.RegistryDirectory
+
+		if legacy {
+			kindDirectory = check.LegacyIndexDirectory + "/" + kind.LegacyDirectory
+		}
+
+		if directory != kindDirectory {
+			continue
+		}
+
+		opts.Contents = true
+
+		if kind == check.KindFunction {
+			opts.ArgumentsHeadingText = "Arguments"
+		} else {
+			opts.Noun = kind.Noun
+		}
+	}
+
+	return opts
+}
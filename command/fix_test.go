@@ -0,0 +1,101 @@
+// Copyright IBM Corp. 2019, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package command
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/YakDriver/tfproviderdocs/fix"
+)
+
+func TestFixOptions(t *testing.T) {
+	config := FixCommandConfig{
+		ProviderName:          "test",
+		RequireSchemaOrdering: true,
+	}
+
+	testCases := []struct {
+		Name      string
+		Directory string
+		Expect    *fix.Options
+	}{
+		{
+			Name:      "registry resources",
+			Directory: "docs/resources",
+			Expect: &fix.Options{
+				Contents:                 true,
+				ForbiddenFrontMatterKeys: []string{"layout", "sidebar_current"},
+				Noun:                     "resource",
+			},
+		},
+		{
+			Name:      "registry functions",
+			Directory: "docs/functions",
+			Expect: &fix.Options{
+				ArgumentsHeadingText:     "Arguments",
+				Contents:                 true,
+				ForbiddenFrontMatterKeys: []string{"layout", "sidebar_current"},
+			},
+		},
+		{
+			Name:      "registry guides",
+			Directory: "docs/guides",
+			Expect: &fix.Options{
+				ForbiddenFrontMatterKeys: []string{"layout", "sidebar_current"},
+			},
+		},
+		{
+			Name:      "legacy data sources",
+			Directory: "website/docs/d",
+			Expect: &fix.Options{
+				Contents:                 true,
+				ForbiddenFrontMatterKeys: []string{"sidebar_current"},
+				Noun:                     "data source",
+			},
+		},
+		{
+			Name:      "cdktf",
+			Directory: "docs/cdktf/python/r",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := fixOptions(testCase.Directory, config)
+
+			if testCase.Expect == nil {
+				if got != nil {
+					t.Fatalf("expected no options, got %#v", got)
+				}
+
+				return
+			}
+
+			if got == nil {
+				t.Fatalf("expected options, got none")
+			}
+
+			if got.ArgumentsHeadingText != testCase.Expect.ArgumentsHeadingText {
+				t.Errorf("expected arguments heading text %q, got %q", testCase.Expect.ArgumentsHeadingText, got.ArgumentsHeadingText)
+			}
+
+			if got.Contents != testCase.Expect.Contents {
+				t.Errorf("expected contents %t, got %t", testCase.Expect.Contents, got.Contents)
+			}
+
+			if !slices.Equal(got.ForbiddenFrontMatterKeys, testCase.Expect.ForbiddenFrontMatterKeys) {
+				t.Errorf("expected forbidden frontmatter keys %v, got %v", testCase.Expect.ForbiddenFrontMatterKeys, got.ForbiddenFrontMatterKeys)
+			}
+
+			if got.Noun != testCase.Expect.Noun {
+				t.Errorf("expected noun %q, got %q", testCase.Expect.Noun, got.Noun)
+			}
+
+			if got.ProviderName != config.ProviderName || got.RequireSchemaOrdering != config.RequireSchemaOrdering {
+				t.Errorf("expected provider name and schema ordering from config, got %#v", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,203 @@
+// Copyright IBM Corp. 2019, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package command
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/YakDriver/tfproviderdocs/check"
+	"github.com/YakDriver/tfproviderdocs/migrate"
+	"github.com/mitchellh/cli"
+)
+
+type MigrateCommandConfig struct {
+	DryRun   bool
+	LogLevel string
+	Path     string
+}
+
+// MigrateCommand is a Command implementation
+type MigrateCommand struct {
+	Ui cli.Ui
+}
+
+func (*MigrateCommand) Help() string {
+	optsBuffer := bytes.NewBuffer([]byte{})
+	opts := tabwriter.NewWriter(optsBuffer, 0, 0, 1, ' ', 0)
+	LogLevelFlagHelp(opts)
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-dry-run", "Only print the documentation files which would be moved.")
+	opts.Flush()
+
+	helpText := fmt.Sprintf(`
+Usage: tfproviderdocs migrate [options] [PATH]
+
+  Moves documentation files of the given Terraform Provider codebase from the legacy website/docs layout to the Terraform Registry docs layout.
+
+Options:
+
+%s
+`, optsBuffer.String())
+
+	return strings.TrimSpace(helpText)
+}
+
+func (c *MigrateCommand) Name() string { return "migrate" }
+
+func (c *MigrateCommand) Run(args []string) int {
+	var config MigrateCommandConfig
+
+	flags := flag.NewFlagSet(c.Name(), flag.ContinueOnError)
+	flags.Usage = func() { c.Ui.Info(c.Help()) }
+	LogLevelFlag(flags, &config.LogLevel)
+	flags.BoolVar(&config.DryRun, "dry-run", false, "")
+
+	if err := flags.Parse(args); err != nil {
+		flags.Usage()
+		return 1
+	}
+
+	args = flags.Args()
+
+	if len(args) == 1 {
+		config.Path = args[0]
+	}
+
+	ConfigureLogging(c.Name(), config.LogLevel)
+
+	directories, err := check.GetDirectories(config.Path)
+
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error getting Terraform Provider documentation directories: %s", err))
+		return 1
+	}
+
+	moves, skipped, err := migrate.Plan(directories)
+
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error migrating Terraform Provider documentation: %s", err))
+		return 1
+	}
+
+	for _, path := range skipped {
+		log.Printf("[WARN] Skipping legacy documentation file without Terraform Registry equivalent: %s", path)
+	}
+
+	if len(moves) == 0 {
+		c.Ui.Info("No legacy documentation files found")
+		return 0
+	}
+
+	// Report the whole migration and verify no file would be overwritten
+	// before changing anything.
+	for _, move := range moves {
+		if _, err := os.Stat(filepath.Join(config.Path, move.To)); err == nil {
+			c.Ui.Error(fmt.Sprintf("Error migrating Terraform Provider documentation: destination file (%s) of %s already exists", move.To, move.From))
+			return 1
+		}
+
+		c.Ui.Output(fmt.Sprintf("%s -> %s", move.From, move.To))
+	}
+
+	if config.DryRun {
+		c.Ui.Info(fmt.Sprintf("Would move %d documentation files", len(moves)))
+		return 0
+	}
+
+	// Read and rewrite every file before writing any, so an unreadable file
+	// leaves the documentation unchanged.
+	type migratedFile struct {
+		move   *migrate.Move
+		mode   os.FileMode
+		source []byte
+	}
+
+	var files []migratedFile
+
+	for _, move := range moves {
+		from := filepath.Join(config.Path, move.From)
+
+		info, err := os.Stat(from)
+
+		if err != nil {
+			c.Ui.Error(fmt.Sprintf("Error reading documentation file (%s): %s", move.From, err))
+			return 1
+		}
+
+		source, err := os.ReadFile(from)
+
+		if err != nil {
+			c.Ui.Error(fmt.Sprintf("Error reading documentation file (%s): %s", move.From, err))
+			return 1
+		}
+
+		result, err := move.Source(source, moves)
+
+		if err != nil {
+			c.Ui.Error(fmt.Sprintf("Error migrating documentation file (%s): %s", move.From, err))
+			return 1
+		}
+
+		files = append(files, migratedFile{move: move, mode: info.Mode().Perm(), source: result})
+	}
+
+	var directoriesToRemove []string
+
+	for _, file := range files {
+		move := file.move
+		from := filepath.Join(config.Path, move.From)
+		to := filepath.Join(config.Path, move.To)
+
+		if err := os.MkdirAll(filepath.Dir(to), 0755); err != nil {
+			c.Ui.Error(fmt.Sprintf("Error creating documentation directory: %s", err))
+			return 1
+		}
+
+		if err := os.WriteFile(to, file.source, file.mode); err != nil {
+			c.Ui.Error(fmt.Sprintf("Error writing documentation file (%s): %s", move.To, err))
+			return 1
+		}
+
+		if err := os.Remove(from); err != nil {
+			c.Ui.Error(fmt.Sprintf("Error removing documentation file (%s): %s", move.From, err))
+			return 1
+		}
+
+		for directory := filepath.Dir(move.From); directory != "." && directory != string(filepath.Separator); directory = filepath.Dir(directory) {
+			directoriesToRemove = append(directoriesToRemove, directory)
+		}
+	}
+
+	// Remove legacy directories left empty, deepest first.
+	slices.SortFunc(directoriesToRemove, func(a, b string) int {
+		if len(a) != len(b) {
+			return len(b) - len(a)
+		}
+
+		return strings.Compare(a, b)
+	})
+
+	for _, directory := range slices.Compact(directoriesToRemove) {
+		if entries, err := os.ReadDir(filepath.Join(config.Path, directory)); err == nil && len(entries) == 0 {
+			if err := os.Remove(filepath.Join(config.Path, directory)); err != nil {
+				log.Printf("[WARN] Unable to remove empty legacy documentation directory (%s): %s", directory, err)
+			}
+		}
+	}
+
+	c.Ui.Info(fmt.Sprintf("Moved %d documentation files", len(moves)))
+
+	return 0
+}
+
+func (c *MigrateCommand) Synopsis() string {
+	return "Migrates legacy Terraform Provider documentation to the Terraform Registry layout"
+}
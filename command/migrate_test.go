@@ -0,0 +1,110 @@
+// Copyright IBM Corp. 2019, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package command
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/mitchellh/cli"
+)
+
+func TestMigrateCommandRun(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Args        []string
+		ExistingTo  bool
+		ExpectCode  int
+		ExpectMoved bool
+		Unreadable  bool
+	}{
+		{
+			Name:       "dry run",
+			Args:       []string{"-dry-run"},
+			ExpectCode: 0,
+		},
+		{
+			Name:        "migrate",
+			ExpectCode:  0,
+			ExpectMoved: true,
+		},
+		{
+			Name:       "existing destination",
+			ExistingTo: true,
+			ExpectCode: 1,
+		},
+		{
+			Name:       "unreadable file",
+			ExpectCode: 1,
+			Unreadable: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			dir := t.TempDir()
+			from := filepath.Join(dir, "website", "docs", "r", "thing.html.markdown")
+			to := filepath.Join(dir, "docs", "resources", "thing.md")
+
+			if err := os.MkdirAll(filepath.Dir(from), 0755); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if err := os.WriteFile(from, []byte("---\nlayout: \"test\"\nsubcategory: \"Example\"\n---\n\n# Resource: test_thing\n"), 0644); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			// A dangling symbolic link sorted after the readable file cannot be
+			// read, which must leave every file in place.
+			if testCase.Unreadable {
+				if err := os.Symlink(filepath.Join(dir, "missing"), filepath.Join(filepath.Dir(from), "zzz.html.markdown")); err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+			}
+
+			if testCase.ExistingTo {
+				if err := os.MkdirAll(filepath.Dir(to), 0755); err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if err := os.WriteFile(to, []byte("existing\n"), 0644); err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+			}
+
+			ui := cli.NewMockUi()
+			command := &MigrateCommand{Ui: ui}
+
+			if got := command.Run(append(testCase.Args, dir)); got != testCase.ExpectCode {
+				t.Fatalf("expected exit code %d, got %d: %s", testCase.ExpectCode, got, ui.ErrorWriter.String())
+			}
+
+			_, fromErr := os.Stat(from)
+
+			if testCase.ExpectMoved != os.IsNotExist(fromErr) {
+				t.Errorf("expected moved %t, source file error: %v", testCase.ExpectMoved, fromErr)
+			}
+
+			if !testCase.ExpectMoved {
+				return
+			}
+
+			got, err := os.ReadFile(to)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if strings.Contains(string(got), "layout:") {
+				t.Errorf("expected layout frontmatter to be removed, got:\n%s", got)
+			}
+
+			if _, err := os.Stat(filepath.Join(dir, "website")); !os.IsNotExist(err) {
+				t.Errorf("expected empty legacy directories to be removed, got: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,270 @@
+// Copyright IBM Corp. 2019, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package command
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/YakDriver/tfproviderdocs/check"
+	"github.com/YakDriver/tfproviderdocs/scaffold"
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/mitchellh/cli"
+)
+
+type ScaffoldCommandConfig struct {
+	DryRun              bool
+	Layout              string
+	LogLevel            string
+	Path                string
+	ProviderName        string
+	ProviderSource      string
+	ProvidersSchemaJson string
+	Subcategory         string
+}
+
+// ScaffoldCommand is a Command implementation
+type ScaffoldCommand struct {
+	Ui cli.Ui
+}
+
+func (*ScaffoldCommand) Help() string {
+	optsBuffer := bytes.NewBuffer([]byte{})
+	opts := tabwriter.NewWriter(optsBuffer, 0, 0, 1, ' ', 0)
+	LogLevelFlagHelp(opts)
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-dry-run", "Only print the documentation files which would be created.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-layout", fmt.Sprintf("Documentation directory layout. Valid values: %s, %s. Defaults to the layout of existing documentation, otherwise %s.", scaffold.LayoutLegacy, scaffold.LayoutRegistry, scaffold.LayoutRegistry))
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-provider-name", "Terraform Provider short name (e.g. aws). Automatically determined if -provider-source is given or if current working directory or provided path is prefixed with terraform-provider-*.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-provider-source", "Terraform Provider source address (e.g. registry.terraform.io/hashicorp/aws) for Terraform CLI 0.13 and later -providers-schema-json. Automatically sets -provider-name by dropping hostname and namespace prefix.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-providers-schema-json", "Path to terraform providers schema -json file. Required.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-subcategory", "Frontmatter subcategory of created documentation files.")
+	opts.Flush()
+
+	helpText := fmt.Sprintf(`
+Usage: tfproviderdocs scaffold [options] [PATH]
+
+  Creates skeleton documentation files for schema resources of the given Terraform Provider codebase which are missing documentation.
+
+Options:
+
+%s
+`, optsBuffer.String())
+
+	return strings.TrimSpace(helpText)
+}
+
+func (c *ScaffoldCommand) Name() string { return "scaffold" }
+
+func (c *ScaffoldCommand) Run(args []string) int {
+	var config ScaffoldCommandConfig
+
+	flags := flag.NewFlagSet(c.Name(), flag.ContinueOnError)
+	flags.Usage = func() { c.Ui.Info(c.Help()) }
+	LogLevelFlag(flags, &config.LogLevel)
+	flags.BoolVar(&config.DryRun, "dry-run", false, "")
+	flags.StringVar(&config.Layout, "layout", "", "")
+	flags.StringVar(&config.ProviderName, "provider-name", "", "")
+	flags.StringVar(&config.ProviderSource, "provider-source", "", "")
+	flags.StringVar(&config.ProvidersSchemaJson, "providers-schema-json", "", "")
+	flags.StringVar(&config.Subcategory, "subcategory", "", "")
+
+	if err := flags.Parse(args); err != nil {
+		flags.Usage()
+		return 1
+	}
+
+	args = flags.Args()
+
+	if len(args) == 1 {
+		config.Path = args[0]
+	}
+
+	ConfigureLogging(c.Name(), config.LogLevel)
+
+	if config.ProvidersSchemaJson == "" {
+		c.Ui.Error("Error scaffolding Terraform Provider documentation: -providers-schema-json is required")
+		return 1
+	}
+
+	if config.ProviderName == "" && config.ProviderSource != "" {
+		providerSourceParts := strings.Split(config.ProviderSource, "/")
+		config.ProviderName = providerSourceParts[len(providerSourceParts)-1]
+	}
+
+	if config.ProviderName == "" {
+		if config.Path == "" {
+			config.ProviderName = providerNameFromCurrentDirectory()
+		} else {
+			config.ProviderName = providerNameFromPath(config.Path)
+		}
+	}
+
+	if config.ProviderName == "" {
+		c.Ui.Error("Error scaffolding Terraform Provider documentation: unknown provider name, use -provider-name or -provider-source")
+		return 1
+	}
+
+	directories, err := check.GetDirectories(config.Path)
+
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error getting Terraform Provider documentation directories: %s", err))
+		return 1
+	}
+
+	if config.Layout == "" {
+		config.Layout = scaffoldLayout(directories)
+	}
+
+	if config.Layout != scaffold.LayoutLegacy && config.Layout != scaffold.LayoutRegistry {
+		c.Ui.Error(fmt.Sprintf("Error scaffolding Terraform Provider documentation: unsupported layout (%s), should be one of: %s, %s", config.Layout, scaffold.LayoutLegacy, scaffold.LayoutRegistry))
+		return 1
+	}
+
+	ps, err := providerSchemas(config.ProvidersSchemaJson)
+
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error loading Terraform Provider schema: %s", err))
+		return 1
+	}
+
+	provider := providerSchema(ps, config.ProviderName, config.ProviderSource)
+
+	if provider == nil {
+		c.Ui.Error(fmt.Sprintf("Error loading Terraform Provider schema: provider source (%s) and name (%s) not found", config.ProviderSource, config.ProviderName))
+		return 1
+	}
+
+	pages := scaffoldPages(provider, directories, config)
+
+	for _, page := range pages {
+		path := page.Path()
+
+		if config.DryRun {
+			c.Ui.Output(fmt.Sprintf("Would create: %s", path))
+			continue
+		}
+
+		fullpath := filepath.Join(config.Path, path)
+
+		if _, err := os.Stat(fullpath); err == nil {
+			log.Printf("[WARN] Skipping existing documentation file: %s", path)
+			continue
+		}
+
+		if err := os.MkdirAll(filepath.Dir(fullpath), 0755); err != nil {
+			c.Ui.Error(fmt.Sprintf("Error creating documentation directory: %s", err))
+			return 1
+		}
+
+		if err := os.WriteFile(fullpath, page.Render(), 0644); err != nil {
+			c.Ui.Error(fmt.Sprintf("Error writing documentation file (%s): %s", path, err))
+			return 1
+		}
+
+		c.Ui.Info(fmt.Sprintf("Created: %s", path))
+	}
+
+	if len(pages) == 0 {
+		c.Ui.Info("No missing documentation files found")
+	}
+
+	return 0
+}
+
+func (c *ScaffoldCommand) Synopsis() string {
+	return "Creates missing Terraform Provider documentation from the schema"
+}
+
+// scaffoldLayout returns the layout of the existing documentation directories,
+// defaulting to the Terraform Registry layout.
+func scaffoldLayout(directories map[string][]string) string {
+	for directory := range directories {
+		if directory == check.LegacyIndexDirectory || strings.HasPrefix(directory, check.LegacyIndexDirectory+"/") {
+			return scaffold.LayoutLegacy
+		}
+	}
+
+	return scaffold.LayoutRegistry
+}
+
+// scaffoldPages returns the pages of every schema without a documentation
+// file, sorted by path.
+func scaffoldPages(provider *tfjson.ProviderSchema, directories map[string][]string, config ScaffoldCommandConfig) []*scaffold.Page {
+	var result []*scaffold.Page
+
+	for _, kind := range scaffold.Kinds {
+		var schemas map[string]*tfjson.SchemaBlock
+		var functions map[string]*tfjson.FunctionSignature
+
+		switch kind {
+		case scaffold.KindAction:
+			schemas = schemaBlocks(provider.ActionSchemas, actionSchemaBlock)
+		case scaffold.KindDataSource:
+			schemas = schemaBlocks(provider.DataSourceSchemas, schemaBlock)
+		case scaffold.KindEphemeral:
+			schemas = schemaBlocks(provider.EphemeralResourceSchemas, schemaBlock)
+		case scaffold.KindFunction:
+			functions = provider.Functions
+		case scaffold.KindListResource:
+			schemas = schemaBlocks(provider.ListResourceSchemas, schemaBlock)
+		case scaffold.KindResource:
+			schemas = schemaBlocks(provider.ResourceSchemas, schemaBlock)
+		}
+
+		directory := fmt.Sprintf("%s/%s", check.RegistryIndexDirectory, kind.RegistryDirectory)
+		providerName := config.ProviderName
+
+		if config.Layout == scaffold.LayoutLegacy {
+			directory = fmt.Sprintf("%s/%s", check.LegacyIndexDirectory, kind.LegacyDirectory)
+		}
+
+		// Function documentation file names are not prefixed by the provider name.
+		if kind == scaffold.KindFunction {
+			providerName = ""
+		}
+
+		var names []string
+
+		for name := range schemas {
+			names = append(names, name)
+		}
+
+		for name := range functions {
+			names = append(names, name)
+		}
+
+		slices.Sort(names)
+
+		mismatchCheck := check.NewFileMismatchCheck(&check.FileMismatchOptions{
+			ProviderName:  providerName,
+			ResourceType:  kind.ResourceType,
+			ResourceNames: names,
+		})
+
+		for _, name := range mismatchCheck.MissingResourceNames(directories[directory]) {
+			result = append(result, &scaffold.Page{
+				Function:     functions[name],
+				Kind:         kind,
+				Layout:       config.Layout,
+				Name:         name,
+				ProviderName: config.ProviderName,
+				Schema:       schemas[name],
+				Subcategory:  config.Subcategory,
+			})
+		}
+	}
+
+	slices.SortFunc(result, func(a, b *scaffold.Page) int {
+		return strings.Compare(a.Path(), b.Path())
+	})
+
+	return result
+}
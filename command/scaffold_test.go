@@ -0,0 +1,125 @@
+// Copyright IBM Corp. 2019, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package command
+
+import (
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/YakDriver/tfproviderdocs/scaffold"
+	tfjson "github.com/hashicorp/terraform-json"
+)
+
+func TestScaffoldLayout(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Directories map[string][]string
+		Expect      string
+	}{
+		{
+			Name:   "no directories",
+			Expect: scaffold.LayoutRegistry,
+		},
+		{
+			Name: "legacy",
+			Directories: map[string][]string{
+				"website/docs":   {"website/docs/index.html.markdown"},
+				"website/docs/r": {"website/docs/r/thing.html.markdown"},
+			},
+			Expect: scaffold.LayoutLegacy,
+		},
+		{
+			Name: "registry",
+			Directories: map[string][]string{
+				"docs/resources": {"docs/resources/thing.md"},
+			},
+			Expect: scaffold.LayoutRegistry,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			if got, want := scaffoldLayout(testCase.Directories), testCase.Expect; got != want {
+				t.Errorf("expected %s, got %s", want, got)
+			}
+		})
+	}
+}
+
+func TestScaffoldPages(t *testing.T) {
+	provider := &tfjson.ProviderSchema{
+		DataSourceSchemas: map[string]*tfjson.Schema{
+			"test_thing": {Block: &tfjson.SchemaBlock{}},
+		},
+		Functions: map[string]*tfjson.FunctionSignature{
+			"parse": {},
+		},
+		ResourceSchemas: map[string]*tfjson.Schema{
+			"test_other": {Block: &tfjson.SchemaBlock{}},
+			"test_thing": {Block: &tfjson.SchemaBlock{}},
+		},
+	}
+
+	testCases := []struct {
+		Name        string
+		Directories map[string][]string
+		Layout      string
+		Expect      []string
+	}{
+		{
+			Name:   "no documentation",
+			Layout: scaffold.LayoutRegistry,
+			Expect: []string{
+				"docs/data-sources/thing.md",
+				"docs/functions/parse.md",
+				"docs/resources/other.md",
+				"docs/resources/thing.md",
+			},
+		},
+		{
+			Name: "registry documentation",
+			Directories: map[string][]string{
+				"docs/functions": {"docs/functions/parse.md"},
+				"docs/resources": {"docs/resources/thing.md"},
+			},
+			Layout: scaffold.LayoutRegistry,
+			Expect: []string{
+				"docs/data-sources/thing.md",
+				"docs/resources/other.md",
+			},
+		},
+		{
+			Name: "legacy documentation",
+			Directories: map[string][]string{
+				"website/docs/d": {"website/docs/d/thing.html.markdown"},
+				"website/docs/r": {"website/docs/r/other.html.markdown"},
+			},
+			Layout: scaffold.LayoutLegacy,
+			Expect: []string{
+				"website/docs/functions/parse.html.markdown",
+				"website/docs/r/thing.html.markdown",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			config := ScaffoldCommandConfig{
+				Layout:       testCase.Layout,
+				ProviderName: "test",
+			}
+
+			var got []string
+
+			for _, page := range scaffoldPages(provider, testCase.Directories, config) {
+				got = append(got, filepath.ToSlash(page.Path()))
+			}
+
+			if !slices.Equal(got, testCase.Expect) {
+				t.Errorf("expected %v, got %v", testCase.Expect, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,201 @@
+// Copyright IBM Corp. 2019, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package fix
+
+import (
+	"fmt"
+	"strings"
+)
+
+// diffContextLines is the number of unchanged lines around changes in unified
+// diff hunks.
+const diffContextLines = 3
+
+// diffOp represents a line of a diff: ' ' unchanged, '-' removed, '+' added.
+type diffOp struct {
+	Kind byte
+	Line string
+}
+
+// Diff returns the unified diff of the original and fixed source of the file
+// path, or an empty string if they are equal.
+func Diff(path string, original []byte, fixed []byte) string {
+	a := splitLines(string(original))
+	b := splitLines(string(fixed))
+	ops := diffLines(a, b)
+
+	var sb strings.Builder
+
+	for _, hunk := range diffHunks(ops) {
+		if sb.Len() == 0 {
+			fmt.Fprintf(&sb, "--- a/%s\n+++ b/%s\n", path, path)
+		}
+
+		sb.WriteString(hunk)
+	}
+
+	return sb.String()
+}
+
+// diffLines returns the line operations transforming a into b, using the
+// longest common subsequence of lines between the common prefix and suffix.
+func diffLines(a []string, b []string) []diffOp {
+	var prefix, suffix int
+
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	var result []diffOp
+
+	for _, line := range a[:prefix] {
+		result = append(result, diffOp{Kind: ' ', Line: line})
+	}
+
+	x := a[prefix : len(a)-suffix]
+	y := b[prefix : len(b)-suffix]
+
+	// lcs[i][j] is the common subsequence length of x[i:] and y[j:].
+	lcs := make([][]int, len(x)+1)
+
+	for i := range lcs {
+		lcs[i] = make([]int, len(y)+1)
+	}
+
+	for i := len(x) - 1; i >= 0; i-- {
+		for j := len(y) - 1; j >= 0; j-- {
+			if x[i] == y[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	i, j := 0, 0
+
+	for i < len(x) || j < len(y) {
+		switch {
+		case i < len(x) && j < len(y) && x[i] == y[j]:
+			result = append(result, diffOp{Kind: ' ', Line: x[i]})
+			i++
+			j++
+		case j == len(y) || (i < len(x) && lcs[i+1][j] >= lcs[i][j+1]):
+			result = append(result, diffOp{Kind: '-', Line: x[i]})
+			i++
+		default:
+			result = append(result, diffOp{Kind: '+', Line: y[j]})
+			j++
+		}
+	}
+
+	for _, line := range a[len(a)-suffix:] {
+		result = append(result, diffOp{Kind: ' ', Line: line})
+	}
+
+	return result
+}
+
+// diffHunks returns the unified diff hunks of the line operations.
+func diffHunks(ops []diffOp) []string {
+	var result []string
+
+	for start := 0; start < len(ops); {
+		// Find the next change.
+		for start < len(ops) && ops[start].Kind == ' ' {
+			start++
+		}
+
+		if start == len(ops) {
+			break
+		}
+
+		// Extend the hunk while changes are within twice the context.
+		end := start
+
+		for i := start; i < len(ops); i++ {
+			if ops[i].Kind != ' ' {
+				end = i + 1
+			} else if i-end >= 2*diffContextLines {
+				break
+			}
+		}
+
+		hunkStart := max(start-diffContextLines, 0)
+		hunkEnd := min(end+diffContextLines, len(ops))
+
+		// Line numbers of the hunk start in the original and fixed source.
+		aLine, bLine := 1, 1
+
+		for _, op := range ops[:hunkStart] {
+			if op.Kind != '+' {
+				aLine++
+			}
+
+			if op.Kind != '-' {
+				bLine++
+			}
+		}
+
+		var aCount, bCount int
+		var lines strings.Builder
+
+		for _, op := range ops[hunkStart:hunkEnd] {
+			if op.Kind != '+' {
+				aCount++
+			}
+
+			if op.Kind != '-' {
+				bCount++
+			}
+
+			lines.WriteByte(op.Kind)
+			lines.WriteString(op.Line)
+
+			if !strings.HasSuffix(op.Line, "\n") {
+				lines.WriteString("\n\\ No newline at end of file\n")
+			}
+		}
+
+		result = append(result, fmt.Sprintf("@@ -%s +%s @@\n%s", hunkRange(aLine, aCount), hunkRange(bLine, bCount), lines.String()))
+
+		start = hunkEnd
+	}
+
+	return result
+}
+
+// hunkRange returns the unified diff range of a hunk, where empty ranges are
+// numbered by the preceding line.
+func hunkRange(line int, count int) string {
+	if count == 0 {
+		line--
+	}
+
+	if count == 1 {
+		return fmt.Sprintf("%d", line)
+	}
+
+	return fmt.Sprintf("%d,%d", line, count)
+}
+
+// splitLines returns the lines of the text, including their line endings.
+func splitLines(text string) []string {
+	if text == "" {
+		return nil
+	}
+
+	result := strings.SplitAfter(text, "\n")
+
+	// Text ending with a line ending has no further line.
+	if result[len(result)-1] == "" {
+		result = result[:len(result)-1]
+	}
+
+	return result
+}
@@ -0,0 +1,94 @@
+// Copyright IBM Corp. 2019, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package fix
+
+import (
+	"testing"
+)
+
+func TestDiff(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Original string
+		Fixed    string
+		Expect   string
+	}{
+		{
+			Name:     "equal",
+			Original: "a\nb\n",
+			Fixed:    "a\nb\n",
+		},
+		{
+			Name:     "changed line",
+			Original: "1\n2\n3\n4\n5\n6\n7\n8\n",
+			Fixed:    "1\n2\n3\n4\nfive\n6\n7\n8\n",
+			Expect: `--- a/test.md
++++ b/test.md
+@@ -2,7 +2,7 @@
+ 2
+ 3
+ 4
+-5
++five
+ 6
+ 7
+ 8
+`,
+		},
+		{
+			Name:     "removed line",
+			Original: "a\nb\nc\n",
+			Fixed:    "a\nc\n",
+			Expect: `--- a/test.md
++++ b/test.md
+@@ -1,3 +1,2 @@
+ a
+-b
+ c
+`,
+		},
+		{
+			Name:     "separate hunks",
+			Original: "a\n1\n2\n3\n4\n5\n6\n7\n8\nb\n",
+			Fixed:    "A\n1\n2\n3\n4\n5\n6\n7\n8\nB\n",
+			Expect: `--- a/test.md
++++ b/test.md
+@@ -1,4 +1,4 @@
+-a
++A
+ 1
+ 2
+ 3
+@@ -7,4 +7,4 @@
+ 6
+ 7
+ 8
+-b
++B
+`,
+		},
+		{
+			Name:     "no newline at end of file",
+			Original: "a\nb",
+			Fixed:    "a\nc",
+			Expect: `--- a/test.md
++++ b/test.md
+@@ -1,2 +1,2 @@
+ a
+-b
+\ No newline at end of file
++c
+\ No newline at end of file
+`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			if got := Diff("test.md", []byte(testCase.Original), []byte(testCase.Fixed)); got != testCase.Expect {
+				t.Errorf("expected:\n%s\n\ngot:\n%s", testCase.Expect, got)
+			}
+		})
+	}
+}
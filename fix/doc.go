@@ -0,0 +1,12 @@
+// Copyright IBM Corp. 2019, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Package fix rewrites Terraform Provider documentation files to correct
+// findings which have a single safe correction, such as unsorted argument
+// lists or forbidden frontmatter. Edits replace whole source lines located
+// from the Markdown AST so all other content stays byte-identical.
+//
+// Deprecated: tfproviderdocs is no longer maintained. All functionality has
+// been superseded by github.com/YakDriver/swissshepherd. Please migrate:
+// https://github.com/YakDriver/swissshepherd
+package fix
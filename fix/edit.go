@@ -0,0 +1,64 @@
+// Copyright IBM Corp. 2019, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package fix
+
+import (
+	"bytes"
+	"slices"
+)
+
+// Edit represents the replacement of a source byte range.
+type Edit struct {
+	// End is the exclusive byte offset where the replaced range ends.
+	End int
+
+	// Rule is the identifier of the finding which the edit corrects, if any.
+	Rule string
+
+	// Start is the byte offset where the replaced range begins.
+	Start int
+
+	// Text is the replacement of the range.
+	Text string
+}
+
+// Apply returns the source with the edits applied. Edits overlapping an
+// earlier edit are skipped, so a later pass can apply them to the result.
+func Apply(source []byte, edits []*Edit) []byte {
+	edits = slices.Clone(edits)
+
+	slices.SortStableFunc(edits, func(a, b *Edit) int {
+		return a.Start - b.Start
+	})
+
+	var b bytes.Buffer
+	var offset int
+
+	for _, edit := range edits {
+		if edit.Start < offset || edit.End > len(source) {
+			continue
+		}
+
+		b.Write(source[offset:edit.Start])
+		b.WriteString(edit.Text)
+		offset = edit.End
+	}
+
+	b.Write(source[offset:])
+
+	return b.Bytes()
+}
+
+// Rules returns the sorted, unique rule identifiers of the edits.
+func Rules(edits []*Edit) []string {
+	var result []string
+
+	for _, edit := range edits {
+		result = append(result, edit.Rule)
+	}
+
+	slices.Sort(result)
+
+	return slices.Compact(result)
+}
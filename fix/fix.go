@@ -0,0 +1,387 @@
+// Copyright IBM Corp. 2019, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package fix
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/YakDriver/tfproviderdocs/check"
+	"github.com/YakDriver/tfproviderdocs/check/contents"
+	"github.com/YakDriver/tfproviderdocs/markdown"
+	"github.com/yuin/goldmark/ast"
+)
+
+// importExampleSuffixRegexp matches an import paragraph concluding with "e.g"
+// rather than "For example:".
+var importExampleSuffixRegexp = regexp.MustCompile(`[,;:.]?\s*[eE]\.g\.?,?:?\s*$`)
+
+// Options represents configuration options for fixes.
+type Options struct {
+	// ArgumentsHeadingText is the expected arguments section heading text.
+	// Defaults to Argument Reference.
+	ArgumentsHeadingText string
+
+	// Contents enables fixes of the documentation contents, such as headings,
+	// bylines, and argument lists. Only the frontmatter is fixed otherwise.
+	Contents bool
+
+	// ForbiddenFrontMatterKeys are the YAML frontmatter keys to remove.
+	ForbiddenFrontMatterKeys []string
+
+	// Noun is the kind of the documented object used in bylines, such as
+	// resource or data source. Bylines are not fixed if empty.
+	Noun string
+
+	ProviderName string
+
+	// RequireSchemaOrdering enables sorting argument and attribute lists by
+	// name.
+	RequireSchemaOrdering bool
+}
+
+// Edits returns the edits correcting the fixable findings of the
+// documentation file source.
+func Edits(path string, source []byte, opts *Options) ([]*Edit, error) {
+	if opts == nil {
+		opts = &Options{}
+	}
+
+	result := frontMatterEdits(source, opts.ForbiddenFrontMatterKeys)
+
+	if !opts.Contents {
+		return result, nil
+	}
+
+	doc := contents.NewDocument(path, opts.ProviderName)
+
+	if err := doc.ParseSource(source); err != nil {
+		return nil, err
+	}
+
+	f := &fixer{
+		opts:   opts,
+		source: source,
+	}
+
+	return append(result, f.sectionEdits(doc.Sections, doc.Layout)...), nil
+}
+
+type fixer struct {
+	opts   *Options
+	source []byte
+}
+
+func (f *fixer) sectionEdits(sections *contents.Sections, layout string) []*Edit {
+	var result []*Edit
+
+	add := func(edits ...*Edit) {
+		for _, edit := range edits {
+			if edit != nil {
+				result = append(result, edit)
+			}
+		}
+	}
+
+	if section := sections.Title; section != nil {
+		add(f.headingEdit(section.Heading, contents.RuleTitleSection, 1, ""))
+	}
+
+	if section := sections.Signature; section != nil {
+		add(f.headingEdit(section.Heading, contents.RuleSignatureSection, 2, ""))
+	}
+
+	if section := sections.Example; section != nil {
+		add(f.headingEdit(section.Heading, contents.RuleExampleSection, 2, "Example Usage"))
+	}
+
+	// terraform-plugin-docs generates its own Schema headings without bylines,
+	// so only list ordering applies to them.
+	if section := sections.Arguments; section != nil && layout == contents.LayoutPluginDocs {
+		if f.opts.RequireSchemaOrdering {
+			add(f.sortEdits((*contents.SchemaAttributeSection)(section), contents.RuleArgumentsOrdering)...)
+		}
+	} else if section != nil {
+		headingText := "Argument Reference"
+
+		if f.opts.ArgumentsHeadingText != "" {
+			headingText = f.opts.ArgumentsHeadingText
+		}
+
+		add(f.headingEdit(section.Heading, contents.RuleArgumentsSection, 2, headingText))
+
+		if f.opts.Noun != "" {
+			expected := []string{
+				fmt.Sprintf("This %s supports the following arguments:", f.opts.Noun),
+				"The following arguments are required:",
+				"The following arguments are optional:",
+			}
+
+			add(f.bylineEdit((*contents.SchemaAttributeSection)(section), contents.RuleArgumentsByline, expected))
+		}
+
+		if f.opts.RequireSchemaOrdering {
+			add(f.sortEdits((*contents.SchemaAttributeSection)(section), contents.RuleArgumentsOrdering)...)
+		}
+	}
+
+	if section := sections.Attributes; section != nil && layout == contents.LayoutPluginDocs {
+		if f.opts.RequireSchemaOrdering {
+			add(f.sortEdits((*contents.SchemaAttributeSection)(section), contents.RuleAttributesOrdering)...)
+		}
+	} else if section != nil {
+		add(f.headingEdit(section.Heading, contents.RuleAttributesSection, 2, "Attribute Reference"))
+
+		if slices.Contains([]string{"data source", "ephemeral resource", "resource"}, f.opts.Noun) {
+			expected := []string{
+				fmt.Sprintf("This %s exports the following attributes in addition to the arguments above:", f.opts.Noun),
+			}
+
+			add(f.bylineEdit((*contents.SchemaAttributeSection)(section), contents.RuleAttributesByline, expected))
+		}
+
+		if f.opts.RequireSchemaOrdering {
+			add(f.sortEdits((*contents.SchemaAttributeSection)(section), contents.RuleAttributesOrdering)...)
+		}
+	}
+
+	if section := sections.Import; section != nil {
+		add(f.headingEdit(section.Heading, contents.RuleImportSection, 2, "Import"))
+
+		if len(section.Paragraphs) > 0 {
+			add(f.importExampleEdit(section.Paragraphs[0]))
+		}
+	}
+
+	return result
+}
+
+// sortEdits returns edits sorting the lists of the section and its nested
+// sections by name.
+func (f *fixer) sortEdits(section *contents.SchemaAttributeSection, rule string) []*Edit {
+	var result []*Edit
+
+	for _, list := range section.SchemaAttributeLists {
+		result = append(result, f.sortEdit(list, rule))
+	}
+
+	for _, child := range section.Children {
+		result = append(result, f.sortEdits(child, rule)...)
+	}
+
+	return result
+}
+
+// headingEdit returns an edit replacing an ATX heading line with the expected
+// level and text, or nil if the heading is as expected. An empty text keeps
+// the existing heading text. Setext headings are left unchanged.
+func (f *fixer) headingEdit(heading *ast.Heading, rule string, level int, text string) *Edit {
+	start, end := markdown.NodeRange(heading, f.source)
+	line, newline := splitLineEnding(f.source[start:end])
+	trimmed := strings.TrimLeft(line, " ")
+
+	if !strings.HasPrefix(trimmed, "#") || strings.Contains(line, "\n") {
+		return nil
+	}
+
+	if text == "" {
+		text = strings.TrimSpace(strings.TrimLeft(trimmed, "#"))
+	}
+
+	if heading.Level == level && string(heading.Text(f.source)) == text {
+		return nil
+	}
+
+	replacement := strings.Repeat("#", level) + " " + text + newline
+
+	if replacement == string(f.source[start:end]) {
+		return nil
+	}
+
+	return &Edit{
+		End:   end,
+		Rule:  rule,
+		Start: start,
+		Text:  replacement,
+	}
+}
+
+// bylineEdit returns an edit replacing a single line byline which introduces
+// the first list of the section with the first expected text, or nil if the
+// byline is expected or cannot be safely replaced.
+func (f *fixer) bylineEdit(section *contents.SchemaAttributeSection, rule string, expected []string) *Edit {
+	if len(section.Paragraphs) == 0 || len(section.SchemaAttributeLists) == 0 || len(section.SchemaAttributeLists[0].Items) == 0 {
+		return nil
+	}
+
+	paragraph := section.Paragraphs[0]
+	text := string(paragraph.Text(f.source))
+
+	if slices.Contains(expected, text) || !strings.HasSuffix(text, ":") {
+		return nil
+	}
+
+	start, end := markdown.NodeRange(paragraph, f.source)
+	listStart, _ := markdown.NodeRange(section.SchemaAttributeLists[0].List, f.source)
+	line, newline := splitLineEnding(f.source[start:end])
+
+	if listStart < end || strings.Contains(line, "\n") {
+		return nil
+	}
+
+	return &Edit{
+		End:   end,
+		Rule:  rule,
+		Start: start,
+		Text:  expected[0] + newline,
+	}
+}
+
+// importExampleEdit returns an edit replacing a concluding "e.g" of the import
+// section paragraph with "For example:", or nil if not found.
+func (f *fixer) importExampleEdit(paragraph *ast.Paragraph) *Edit {
+	start, end := markdown.NodeRange(paragraph, f.source)
+	lines, newline := splitLineEnding(f.source[start:end])
+	loc := importExampleSuffixRegexp.FindStringIndex(lines)
+
+	if loc == nil {
+		return nil
+	}
+
+	return &Edit{
+		End:   end,
+		Rule:  contents.RuleImportSection,
+		Start: start + loc[0],
+		Text:  ". For example:" + newline,
+	}
+}
+
+// sortEdit returns an edit reordering the items of an unsorted list by name,
+// or nil if the list is sorted or contains items without a name. Any lines
+// separating items are kept in place.
+func (f *fixer) sortEdit(list *contents.SchemaAttributeList, rule string) *Edit {
+	if sort.IsSorted(contents.SchemaAttributeListItemByName(list.Items)) || list.List.ChildCount() != len(list.Items) {
+		return nil
+	}
+
+	start, end := markdown.NodeRange(list.List, f.source)
+	chunks := make(map[*contents.SchemaAttributeListItem]string, len(list.Items))
+	separators := make([]string, len(list.Items))
+
+	for i, item := range list.Items {
+		itemStart, _ := markdown.NodeRange(item.ListItem, f.source)
+		itemEnd := end
+
+		if i < len(list.Items)-1 {
+			itemEnd, _ = markdown.NodeRange(list.Items[i+1].ListItem, f.source)
+		}
+
+		chunk := string(f.source[itemStart:itemEnd])
+		content := chunk
+
+		// The item content ends with the line ending of its last non-blank line.
+		trimmed := strings.TrimRight(chunk, " \t\r\n")
+
+		if i := strings.IndexByte(chunk[len(trimmed):], '\n'); i >= 0 {
+			content = chunk[:len(trimmed)+i+1]
+		}
+
+		chunks[item] = content
+		separators[i] = chunk[len(content):]
+	}
+
+	// Moving the last item without a trailing newline requires one.
+	lastItem := list.Items[len(list.Items)-1]
+	trailingNewline := strings.HasSuffix(chunks[lastItem], "\n")
+
+	if !trailingNewline {
+		chunks[lastItem] += "\n"
+	}
+
+	items := slices.Clone(list.Items)
+	sort.Stable(contents.SchemaAttributeListItemByName(items))
+
+	var b strings.Builder
+
+	for i, item := range items {
+		b.WriteString(chunks[item])
+		b.WriteString(separators[i])
+	}
+
+	text := b.String()
+
+	if !trailingNewline {
+		text = strings.TrimSuffix(text, "\n")
+	}
+
+	return &Edit{
+		End:   end,
+		Rule:  rule,
+		Start: start,
+		Text:  text,
+	}
+}
+
+// frontMatterEdits returns edits removing the YAML frontmatter keys, including
+// the indented lines of multiple line values.
+func frontMatterEdits(source []byte, keys []string) []*Edit {
+	frontMatter, _ := markdown.FrontMatter(source)
+
+	if frontMatter == nil || len(keys) == 0 {
+		return nil
+	}
+
+	var result []*Edit
+	var edit *Edit
+
+	start := bytes.IndexByte(source, '\n') + 1
+	frontMatterEnd := start + len(frontMatter)
+
+	for start < frontMatterEnd {
+		end := len(source)
+
+		if i := bytes.IndexByte(source[start:], '\n'); i >= 0 {
+			end = start + i + 1
+		}
+
+		line := source[start:end]
+
+		switch {
+		case edit != nil && (bytes.HasPrefix(line, []byte(" ")) || bytes.HasPrefix(line, []byte("\t")) || bytes.HasPrefix(line, []byte("- "))):
+			edit.End = end
+		case slices.ContainsFunc(keys, func(key string) bool { return bytes.HasPrefix(line, []byte(key+":")) }):
+			edit = &Edit{
+				End:   end,
+				Rule:  check.RuleFrontMatter,
+				Start: start,
+			}
+
+			result = append(result, edit)
+		default:
+			edit = nil
+		}
+
+		start = end
+	}
+
+	return result
+}
+
+// splitLineEnding returns the text without its trailing line ending and the
+// line ending.
+func splitLineEnding(b []byte) (string, string) {
+	text := string(b)
+
+	for _, newline := range []string{"\r\n", "\n"} {
+		if strings.HasSuffix(text, newline) {
+			return strings.TrimSuffix(text, newline), newline
+		}
+	}
+
+	return text, ""
+}
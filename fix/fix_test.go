@@ -0,0 +1,372 @@
+// Copyright IBM Corp. 2019, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package fix
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/YakDriver/tfproviderdocs/check"
+	"github.com/YakDriver/tfproviderdocs/check/contents"
+)
+
+func TestApply(t *testing.T) {
+	testCases := []struct {
+		Name   string
+		Source string
+		Edits  []*Edit
+		Expect string
+	}{
+		{
+			Name:   "no edits",
+			Source: "abcdef",
+			Expect: "abcdef",
+		},
+		{
+			Name:   "unsorted edits",
+			Source: "abcdef",
+			Edits: []*Edit{
+				{Start: 4, End: 5, Text: "E"},
+				{Start: 0, End: 1, Text: "AA"},
+			},
+			Expect: "AAbcdEf",
+		},
+		{
+			Name:   "overlapping edit skipped",
+			Source: "abcdef",
+			Edits: []*Edit{
+				{Start: 0, End: 3, Text: "X"},
+				{Start: 2, End: 4, Text: "Y"},
+			},
+			Expect: "Xdef",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			if got := string(Apply([]byte(testCase.Source), testCase.Edits)); got != testCase.Expect {
+				t.Errorf("expected %q, got %q", testCase.Expect, got)
+			}
+		})
+	}
+}
+
+func TestEdits(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Source      string
+		Options     *Options
+		Expect      string
+		ExpectRules []string
+	}{
+		{
+			Name: "no fixes",
+			Source: `---
+subcategory: "Example"
+---
+
+# Resource: test_thing
+
+## Argument Reference
+
+This resource supports the following arguments:
+
+* ` + "`a`" + ` - (Required) A.
+* ` + "`b`" + ` - (Optional) B.
+`,
+			Options: &Options{
+				Contents:              true,
+				Noun:                  "resource",
+				RequireSchemaOrdering: true,
+			},
+		},
+		{
+			Name: "forbidden frontmatter",
+			Source: `---
+subcategory: "Example"
+layout: "test"
+page_title: "Test: test_thing"
+sidebar_current: |-
+  docs-test-resource-thing
+description: |-
+  Manages a thing.
+---
+
+# Resource: test_thing
+`,
+			Options: &Options{
+				ForbiddenFrontMatterKeys: []string{"layout", "sidebar_current"},
+			},
+			Expect: `---
+subcategory: "Example"
+page_title: "Test: test_thing"
+description: |-
+  Manages a thing.
+---
+
+# Resource: test_thing
+`,
+			ExpectRules: []string{check.RuleFrontMatter},
+		},
+		{
+			Name: "headings",
+			Source: `## Resource: test_thing
+
+### Example
+
+Arguments Reference
+-------------------
+
+## Attributes Reference
+
+# Import
+`,
+			Options: &Options{
+				Contents: true,
+			},
+			Expect: `# Resource: test_thing
+
+## Example Usage
+
+Arguments Reference
+-------------------
+
+## Attribute Reference
+
+## Import
+`,
+			ExpectRules: []string{contents.RuleAttributesSection, contents.RuleExampleSection, contents.RuleImportSection, contents.RuleTitleSection},
+		},
+		{
+			Name: "bylines",
+			Source: `# Resource: test_thing
+
+## Argument Reference
+
+The following arguments are supported:
+
+* ` + "`a`" + ` - (Required) A.
+
+## Attribute Reference
+
+In addition to all arguments above, the following attributes are exported:
+
+* ` + "`id`" + ` - ID.
+`,
+			Options: &Options{
+				Contents: true,
+				Noun:     "resource",
+			},
+			Expect: `# Resource: test_thing
+
+## Argument Reference
+
+This resource supports the following arguments:
+
+* ` + "`a`" + ` - (Required) A.
+
+## Attribute Reference
+
+This resource exports the following attributes in addition to the arguments above:
+
+* ` + "`id`" + ` - ID.
+`,
+			ExpectRules: []string{contents.RuleArgumentsByline, contents.RuleAttributesByline},
+		},
+		{
+			Name: "byline without list",
+			Source: `# Resource: test_thing
+
+## Argument Reference
+
+The arguments are described below:
+
+Nothing here.
+`,
+			Options: &Options{
+				Contents: true,
+				Noun:     "resource",
+			},
+		},
+		{
+			Name: "import example",
+			Source: `# Resource: test_thing
+
+## Import
+
+Import things using the ` + "`id`" + `, e.g.,
+
+` + "```terraform" + `
+import {
+  to = test_thing.example
+  id = "example"
+}
+` + "```" + `
+`,
+			Options: &Options{
+				Contents: true,
+			},
+			Expect: `# Resource: test_thing
+
+## Import
+
+Import things using the ` + "`id`" + `. For example:
+
+` + "```terraform" + `
+import {
+  to = test_thing.example
+  id = "example"
+}
+` + "```" + `
+`,
+			ExpectRules: []string{contents.RuleImportSection},
+		},
+		{
+			Name: "sort lists",
+			Source: `# Resource: test_thing
+
+## Argument Reference
+
+This resource supports the following arguments:
+
+* ` + "`c`" + ` - (Optional) C.
+* ` + "`a`" + ` - (Required) A
+  spanning lines.
+    * ` + "`z`" + ` - (Required) Z.
+    * ` + "`y`" + ` - (Required) Y.
+* ` + "`b`" + ` - (Optional) B.
+
+### ` + "`rule`" + ` Configuration Block
+
+The ` + "`rule`" + ` configuration block supports the following arguments:
+
+* ` + "`priority`" + ` - (Optional) Priority.
+* ` + "`action`" + ` - (Required) Action.
+
+## Attribute Reference
+
+This resource exports the following attributes in addition to the arguments above:
+
+* ` + "`id`" + ` - ID.
+* ` + "`arn`" + ` - ARN.`,
+			Options: &Options{
+				Contents:              true,
+				Noun:                  "resource",
+				RequireSchemaOrdering: true,
+			},
+			Expect: `# Resource: test_thing
+
+## Argument Reference
+
+This resource supports the following arguments:
+
+* ` + "`a`" + ` - (Required) A
+  spanning lines.
+    * ` + "`z`" + ` - (Required) Z.
+    * ` + "`y`" + ` - (Required) Y.
+* ` + "`b`" + ` - (Optional) B.
+* ` + "`c`" + ` - (Optional) C.
+
+### ` + "`rule`" + ` Configuration Block
+
+The ` + "`rule`" + ` configuration block supports the following arguments:
+
+* ` + "`action`" + ` - (Required) Action.
+* ` + "`priority`" + ` - (Optional) Priority.
+
+## Attribute Reference
+
+This resource exports the following attributes in addition to the arguments above:
+
+* ` + "`arn`" + ` - ARN.
+* ` + "`id`" + ` - ID.`,
+			ExpectRules: []string{contents.RuleArgumentsOrdering, contents.RuleAttributesOrdering},
+		},
+		{
+			Name: "sort lists disabled",
+			Source: `# Resource: test_thing
+
+## Argument Reference
+
+This resource supports the following arguments:
+
+* ` + "`c`" + ` - (Optional) C.
+* ` + "`a`" + ` - (Required) A.
+`,
+			Options: &Options{
+				Contents: true,
+				Noun:     "resource",
+			},
+		},
+		{
+			Name: "plugin docs layout",
+			Source: `# test_thing (Resource)
+
+## Schema
+
+### Required
+
+- ` + "`name`" + ` (String) Name.
+
+### Optional
+
+- ` + "`tags`" + ` (Map of String) Tags.
+- ` + "`description`" + ` (String) Description.
+
+### Read-Only
+
+- ` + "`id`" + ` (String) ID.
+`,
+			Options: &Options{
+				Contents:              true,
+				Noun:                  "resource",
+				RequireSchemaOrdering: true,
+			},
+			Expect: `# test_thing (Resource)
+
+## Schema
+
+### Required
+
+- ` + "`name`" + ` (String) Name.
+
+### Optional
+
+- ` + "`description`" + ` (String) Description.
+- ` + "`tags`" + ` (Map of String) Tags.
+
+### Read-Only
+
+- ` + "`id`" + ` (String) ID.
+`,
+			ExpectRules: []string{contents.RuleArgumentsOrdering},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			testCase.Options.ProviderName = "test"
+
+			edits, err := Edits("thing.md", []byte(testCase.Source), testCase.Options)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			expect := testCase.Expect
+
+			if expect == "" {
+				expect = testCase.Source
+			}
+
+			if got := string(Apply([]byte(testCase.Source), edits)); got != expect {
+				t.Errorf("expected:\n%s\n\ngot:\n%s", expect, got)
+			}
+
+			if got := Rules(edits); !slices.Equal(got, testCase.ExpectRules) {
+				t.Errorf("expected rules %v, got %v", testCase.ExpectRules, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,42 @@
+// Copyright IBM Corp. 2019, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package markdown
+
+import (
+	"bytes"
+)
+
+// FrontMatterDelimiter is the line surrounding YAML frontmatter.
+const FrontMatterDelimiter = "---"
+
+// FrontMatter returns the YAML frontmatter of a Markdown source, without the
+// delimiter lines, and the offset of the Markdown content following it. If the
+// source does not start with delimited frontmatter, nil and 0 are returned.
+func FrontMatter(source []byte) ([]byte, int) {
+	start := -1
+
+	for offset := 0; offset < len(source); {
+		end := bytes.IndexByte(source[offset:], '\n')
+		next := len(source)
+
+		if end >= 0 {
+			next = offset + end + 1
+		}
+
+		line := bytes.TrimRight(source[offset:next], " \t\r\n")
+
+		switch {
+		case start == -1 && string(line) != FrontMatterDelimiter:
+			return nil, 0
+		case start == -1:
+			start = next
+		case string(line) == FrontMatterDelimiter:
+			return source[start:offset], next
+		}
+
+		offset = next
+	}
+
+	return nil, 0
+}
@@ -0,0 +1,39 @@
+// Copyright IBM Corp. 2019, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package markdown
+
+import (
+	"bytes"
+
+	"github.com/yuin/goldmark/ast"
+)
+
+// LinkDestinationRange returns the byte offsets of the destination of an
+// inline link in the source. The link text must not be empty. If the
+// destination is not found verbatim, such as for reference links or escaped
+// destinations, -1 and -1 are returned.
+func LinkDestinationRange(link *ast.Link, source []byte) (int, int) {
+	end := nodeEnd(link)
+
+	if end < 0 || len(link.Destination) == 0 {
+		return -1, -1
+	}
+
+	rest := source[end:]
+	i := bytes.Index(rest, []byte("]("))
+
+	// Only closing emphasis or code span delimiters may follow the link text.
+	if i < 0 || len(bytes.Trim(rest[:i], "`*_~")) > 0 {
+		return -1, -1
+	}
+
+	start := end + i + 2
+	j := bytes.Index(source[start:], link.Destination)
+
+	if j < 0 || len(bytes.Trim(source[start:start+j], " \t<")) > 0 {
+		return -1, -1
+	}
+
+	return start + j, start + j + len(link.Destination)
+}
@@ -5,24 +5,19 @@ package markdown
 
 import (
 	"github.com/yuin/goldmark"
-	meta "github.com/yuin/goldmark-meta"
 	"github.com/yuin/goldmark/ast"
-	"github.com/yuin/goldmark/parser"
 	"github.com/yuin/goldmark/text"
 )
 
-// Parse converts a Markdown source into AST and metadata
-func Parse(source []byte) (ast.Node, map[string]any) {
-	markdown := goldmark.New(
-		goldmark.WithExtensions(
-			meta.New(),
-		),
-	)
-
-	context := parser.NewContext()
+// Parse converts a Markdown source into AST. YAML frontmatter is skipped, as
+// it is parsed by the frontmatter check, while node segments remain offsets
+// into the whole source.
+func Parse(source []byte) ast.Node {
 	reader := text.NewReader(source)
-	document := markdown.Parser().Parse(reader, parser.WithContext(context))
-	metadata := meta.Get(context)
 
-	return document, metadata
+	if _, offset := FrontMatter(source); offset > 0 {
+		reader.Advance(offset)
+	}
+
+	return goldmark.New().Parser().Parse(reader)
 }
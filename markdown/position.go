@@ -0,0 +1,158 @@
+// Copyright IBM Corp. 2019, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package markdown
+
+import (
+	"bytes"
+
+	"github.com/yuin/goldmark/ast"
+)
+
+// NodePosition returns the 1-based line and column where the node begins in
+// the source. Headings and fenced code blocks are positioned at the start of
+// their first line. Zeros are returned for nodes without position information.
+func NodePosition(node ast.Node, source []byte) (int, int) {
+	offset := nodeOffset(node)
+
+	if offset < 0 || offset > len(source) {
+		return 0, 0
+	}
+
+	switch node := node.(type) {
+	case *ast.FencedCodeBlock:
+		offset = lineStart(source, offset)
+
+		// Without an info string the first line is the code block contents.
+		if node.Info == nil && offset > 0 {
+			offset = lineStart(source, offset-1)
+		}
+	case *ast.Heading:
+		offset = lineStart(source, offset)
+	}
+
+	return OffsetPosition(source, offset)
+}
+
+// NodeRange returns the byte offsets of the whole lines spanned by the node in
+// the source, including the trailing newline of the last line. Fenced code
+// blocks include their fence lines. Zeros are returned for nodes without
+// position information.
+func NodeRange(node ast.Node, source []byte) (int, int) {
+	start := nodeOffset(node)
+	end := nodeEnd(node)
+
+	if start < 0 || end < start || end > len(source) {
+		return 0, 0
+	}
+
+	start = lineStart(source, start)
+
+	if node, ok := node.(*ast.FencedCodeBlock); ok {
+		if node.Info == nil && start > 0 {
+			start = lineStart(source, start-1)
+		}
+
+		// Include the closing fence line, if any.
+		if next := lineEnd(source, end); next < len(source) {
+			fence := lineEnd(source, next+1)
+
+			if line := bytes.TrimSpace(source[next:fence]); bytes.HasPrefix(line, []byte("```")) || bytes.HasPrefix(line, []byte("~~~")) {
+				end = fence
+			}
+		}
+	}
+
+	if end > start && source[end-1] == '\n' {
+		return start, end
+	}
+
+	return start, lineEnd(source, end)
+}
+
+// OffsetPosition returns the 1-based line and column of a byte offset in the
+// source.
+func OffsetPosition(source []byte, offset int) (int, int) {
+	line := bytes.Count(source[:offset], []byte("\n")) + 1
+	column := offset - lineStart(source, offset) + 1
+
+	return line, column
+}
+
+// nodeOffset returns the byte offset of the first source segment of the node
+// or its descendants, or -1 if none is found.
+func nodeOffset(node ast.Node) int {
+	if node == nil {
+		return -1
+	}
+
+	switch node := node.(type) {
+	case *ast.FencedCodeBlock:
+		if node.Info != nil {
+			return node.Info.Segment.Start
+		}
+	case *ast.Text:
+		return node.Segment.Start
+	}
+
+	if node.Type() == ast.TypeBlock && node.Lines().Len() > 0 {
+		return node.Lines().At(0).Start
+	}
+
+	for child := node.FirstChild(); child != nil; child = child.NextSibling() {
+		if offset := nodeOffset(child); offset >= 0 {
+			return offset
+		}
+	}
+
+	return -1
+}
+
+// nodeEnd returns the byte offset of the end of the last source segment of the
+// node or its descendants, or -1 if none is found.
+func nodeEnd(node ast.Node) int {
+	if node == nil {
+		return -1
+	}
+
+	result := -1
+
+	switch node := node.(type) {
+	case *ast.Text:
+		result = node.Segment.Stop
+	case *ast.FencedCodeBlock:
+		if node.Info != nil {
+			result = node.Info.Segment.Stop
+		}
+	}
+
+	if node.Type() == ast.TypeBlock && node.Lines().Len() > 0 {
+		result = max(result, node.Lines().At(node.Lines().Len()-1).Stop)
+	}
+
+	for child := node.FirstChild(); child != nil; child = child.NextSibling() {
+		result = max(result, nodeEnd(child))
+	}
+
+	return result
+}
+
+// lineEnd returns the byte offset following the newline of the line containing
+// the offset, or the source length for the last line.
+func lineEnd(source []byte, offset int) int {
+	if offset > 0 && source[offset-1] == '\n' {
+		return offset
+	}
+
+	if i := bytes.IndexByte(source[offset:], '\n'); i >= 0 {
+		return offset + i + 1
+	}
+
+	return len(source)
+}
+
+// lineStart returns the byte offset of the start of the line containing the
+// offset.
+func lineStart(source []byte, offset int) int {
+	return bytes.LastIndexByte(source[:offset], '\n') + 1
+}
@@ -0,0 +1,11 @@
+// Copyright IBM Corp. 2019, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Package migrate moves Terraform Provider documentation from the legacy
+// website/docs directory layout to the Terraform Registry docs directory
+// layout, rewriting file contents which differ between the layouts.
+//
+// Deprecated: tfproviderdocs is no longer maintained. All functionality has
+// been superseded by github.com/YakDriver/swissshepherd. Please migrate:
+// https://github.com/YakDriver/swissshepherd
+package migrate
@@ -0,0 +1,239 @@
+// Copyright IBM Corp. 2019, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package migrate
+
+import (
+	"fmt"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/YakDriver/tfproviderdocs/check"
+	"github.com/YakDriver/tfproviderdocs/fix"
+	"github.com/YakDriver/tfproviderdocs/markdown"
+	"github.com/hashicorp/go-multierror"
+	"github.com/yuin/goldmark/ast"
+)
+
+// ForbiddenFrontMatterKeys are the YAML frontmatter keys of legacy
+// documentation which Terraform Registry documentation must not contain.
+var ForbiddenFrontMatterKeys = []string{"layout", "sidebar_current"}
+
+// Subdirectories maps legacy documentation subdirectories to their Terraform
+// Registry equivalent.
+var Subdirectories = map[string]string{
+	check.LegacyActionsDirectory:       check.RegistryActionsDirectory,
+	check.LegacyDataSourcesDirectory:   check.RegistryDataSourcesDirectory,
+	check.LegacyEphemeralsDirectory:    check.RegistryEphemeralsDirectory,
+	check.LegacyFunctionsDirectory:     check.RegistryFunctionsDirectory,
+	check.LegacyGuidesDirectory:        check.RegistryGuidesDirectory,
+	check.LegacyListResourcesDirectory: check.RegistryListResourcesDirectory,
+	check.LegacyResourcesDirectory:     check.RegistryResourcesDirectory,
+}
+
+// Move represents moving a legacy documentation file to the Terraform
+// Registry layout. Paths are relative to the provider root directory.
+type Move struct {
+	From string
+	To   string
+}
+
+// Plan returns the moves of the legacy documentation files in the
+// directories, sorted by source path, and the legacy files which have no
+// Terraform Registry equivalent. It returns an error if multiple files would
+// be moved to the same destination, such as thing.html.markdown and
+// thing.markdown.
+func Plan(directories map[string][]string) ([]*Move, []string, error) {
+	var moves []*Move
+	var skipped []string
+
+	for directory, files := range directories {
+		directory = filepath.ToSlash(directory)
+
+		if !check.IsValidLegacyDirectory(directory) && !(check.IsValidCdktfDirectory(directory) && strings.HasPrefix(directory, check.LegacyIndexDirectory+"/")) {
+			continue
+		}
+
+		for _, file := range files {
+			to, ok := RegistryPath(file)
+
+			if !ok {
+				skipped = append(skipped, file)
+				continue
+			}
+
+			moves = append(moves, &Move{
+				From: file,
+				To:   to,
+			})
+		}
+	}
+
+	slices.SortFunc(moves, func(a, b *Move) int {
+		return strings.Compare(a.From, b.From)
+	})
+	slices.Sort(skipped)
+
+	var result *multierror.Error
+	sources := make(map[string]string)
+
+	for _, move := range moves {
+		if from, ok := sources[move.To]; ok {
+			result = multierror.Append(result, fmt.Errorf("destination file (%s) of %s is also the destination of %s", move.To, move.From, from))
+			continue
+		}
+
+		sources[move.To] = move.From
+	}
+
+	return moves, skipped, result.ErrorOrNil()
+}
+
+// RegistryPath returns the Terraform Registry layout path of a legacy layout
+// documentation file path, or false if the file has no equivalent.
+func RegistryPath(legacyPath string) (string, bool) {
+	legacyPath = filepath.ToSlash(legacyPath)
+	rel, ok := strings.CutPrefix(legacyPath, check.LegacyIndexDirectory+"/")
+
+	if !ok {
+		return "", false
+	}
+
+	var prefix string
+	parts := strings.Split(rel, "/")
+
+	// CDK for Terraform subtrees repeat the layout per language.
+	if len(parts) >= 3 && parts[0] == check.CdktfIndexDirectory && slices.Contains(check.ValidCdktfLanguages, parts[1]) {
+		prefix = path.Join(parts[:2]...)
+		parts = parts[2:]
+	}
+
+	name, ok := trimLegacyFileExtension(parts[len(parts)-1])
+
+	if !ok {
+		return "", false
+	}
+
+	name += check.FileExtensionMd
+
+	switch len(parts) {
+	case 1:
+		return filepath.FromSlash(path.Join(check.RegistryIndexDirectory, prefix, name)), true
+	case 2:
+		subdirectory, ok := Subdirectories[parts[0]]
+
+		if !ok {
+			return "", false
+		}
+
+		return filepath.FromSlash(path.Join(check.RegistryIndexDirectory, prefix, subdirectory, name)), true
+	}
+
+	return "", false
+}
+
+// Source returns the Terraform Registry contents of the moved legacy
+// documentation file source. Forbidden frontmatter is removed and relative
+// links to other moved files are rewritten relative to the new path.
+func (m *Move) Source(source []byte, moves []*Move) ([]byte, error) {
+	edits, err := fix.Edits(m.From, source, &fix.Options{
+		ForbiddenFrontMatterKeys: ForbiddenFrontMatterKeys,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	edits = append(edits, m.linkEdits(source, moves)...)
+
+	return fix.Apply(source, edits), nil
+}
+
+// linkEdits returns the edits rewriting relative links of the source which
+// refer to moved files.
+func (m *Move) linkEdits(source []byte, moves []*Move) []*fix.Edit {
+	targets := make(map[string]*Move)
+
+	for _, move := range moves {
+		from := filepath.ToSlash(move.From)
+		targets[from] = move
+
+		// Legacy pages are linked by their built path, such as thing.html
+		// for thing.html.markdown, or without an extension.
+		if base, ok := trimLegacyFileExtension(from); ok {
+			targets[base] = move
+			targets[base+".html"] = move
+		}
+	}
+
+	var result []*fix.Edit
+	directory := path.Dir(filepath.ToSlash(m.From))
+
+	_ = ast.Walk(markdown.Parse(source), func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+		link, ok := node.(*ast.Link)
+
+		if !entering || !ok {
+			return ast.WalkContinue, nil
+		}
+
+		destination := string(link.Destination)
+		linkPath, suffix := destination, ""
+
+		if i := strings.IndexAny(destination, "?#"); i >= 0 {
+			linkPath, suffix = destination[:i], destination[i:]
+		}
+
+		// Only relative links to other pages are rewritten.
+		if linkPath == "" || strings.HasPrefix(linkPath, "/") || strings.Contains(linkPath, ":") {
+			return ast.WalkContinue, nil
+		}
+
+		target, ok := targets[path.Join(directory, linkPath)]
+
+		if !ok {
+			return ast.WalkContinue, nil
+		}
+
+		start, end := markdown.LinkDestinationRange(link, source)
+
+		if start < 0 {
+			return ast.WalkContinue, nil
+		}
+
+		rel, err := filepath.Rel(filepath.Dir(m.To), target.To)
+
+		if err != nil {
+			return ast.WalkContinue, nil
+		}
+
+		result = append(result, &fix.Edit{
+			End:   end,
+			Start: start,
+			Text:  filepath.ToSlash(rel) + suffix,
+		})
+
+		return ast.WalkContinue, nil
+	})
+
+	return result
+}
+
+// trimLegacyFileExtension returns the name without its longest valid legacy
+// file extension, or false if it has none.
+func trimLegacyFileExtension(name string) (string, bool) {
+	var extension string
+
+	for _, e := range check.ValidLegacyFileExtensions {
+		if strings.HasSuffix(name, e) && len(e) > len(extension) {
+			extension = e
+		}
+	}
+
+	if extension == "" {
+		return name, false
+	}
+
+	return strings.TrimSuffix(name, extension), true
+}
@@ -0,0 +1,200 @@
+// Copyright IBM Corp. 2019, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package migrate
+
+import (
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestRegistryPath(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Path     string
+		Expect   string
+		ExpectOk bool
+	}{
+		{
+			Name:     "index",
+			Path:     "website/docs/index.html.markdown",
+			Expect:   "docs/index.md",
+			ExpectOk: true,
+		},
+		{
+			Name:     "resource",
+			Path:     "website/docs/r/thing.html.markdown",
+			Expect:   "docs/resources/thing.md",
+			ExpectOk: true,
+		},
+		{
+			Name:     "data source",
+			Path:     "website/docs/d/thing.html.md",
+			Expect:   "docs/data-sources/thing.md",
+			ExpectOk: true,
+		},
+		{
+			Name:     "guide",
+			Path:     "website/docs/guides/upgrade.markdown",
+			Expect:   "docs/guides/upgrade.md",
+			ExpectOk: true,
+		},
+		{
+			Name:     "cdktf resource",
+			Path:     "website/docs/cdktf/typescript/r/thing.html.markdown",
+			Expect:   "docs/cdktf/typescript/resources/thing.md",
+			ExpectOk: true,
+		},
+		{
+			Name:     "cdktf index",
+			Path:     "website/docs/cdktf/python/index.html.markdown",
+			Expect:   "docs/cdktf/python/index.md",
+			ExpectOk: true,
+		},
+		{
+			Name: "unknown subdirectory",
+			Path: "website/docs/other/thing.html.markdown",
+		},
+		{
+			Name: "invalid extension",
+			Path: "website/docs/r/thing.txt",
+		},
+		{
+			Name: "registry",
+			Path: "docs/resources/thing.md",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, ok := RegistryPath(testCase.Path)
+
+			if ok != testCase.ExpectOk {
+				t.Fatalf("expected ok %t, got %t", testCase.ExpectOk, ok)
+			}
+
+			if want := filepath.FromSlash(testCase.Expect); got != want {
+				t.Errorf("expected %s, got %s", want, got)
+			}
+		})
+	}
+}
+
+func TestPlan(t *testing.T) {
+	directories := map[string][]string{
+		"docs": {"docs/index.md"},
+		"website/docs": {
+			"website/docs/index.html.markdown",
+		},
+		"website/docs/cdktf/typescript/d": {
+			"website/docs/cdktf/typescript/d/thing.html.markdown",
+		},
+		"website/docs/r": {
+			"website/docs/r/thing.html.markdown",
+			"website/docs/r/thing.png",
+		},
+	}
+
+	moves, skipped, err := Plan(directories)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var got []string
+
+	for _, move := range moves {
+		got = append(got, filepath.ToSlash(move.From)+" -> "+filepath.ToSlash(move.To))
+	}
+
+	want := []string{
+		"website/docs/cdktf/typescript/d/thing.html.markdown -> docs/cdktf/typescript/data-sources/thing.md",
+		"website/docs/index.html.markdown -> docs/index.md",
+		"website/docs/r/thing.html.markdown -> docs/resources/thing.md",
+	}
+
+	if !slices.Equal(got, want) {
+		t.Errorf("expected moves %v, got %v", want, got)
+	}
+
+	if want := []string{"website/docs/r/thing.png"}; !slices.Equal(skipped, want) {
+		t.Errorf("expected skipped %v, got %v", want, skipped)
+	}
+}
+
+func TestPlanDuplicateDestination(t *testing.T) {
+	directories := map[string][]string{
+		"website/docs/r": {
+			"website/docs/r/thing.html.markdown",
+			"website/docs/r/thing.markdown",
+			"website/docs/r/other.markdown",
+		},
+	}
+
+	_, _, err := Plan(directories)
+
+	if err == nil {
+		t.Fatalf("expected error, got no error")
+	}
+
+	want := "destination file (" + filepath.FromSlash("docs/resources/thing.md") + ") of website/docs/r/thing.markdown is also the destination of website/docs/r/thing.html.markdown"
+
+	if !strings.Contains(err.Error(), want) {
+		t.Errorf("expected error containing %q, got: %s", want, err)
+	}
+}
+
+func TestMoveSource(t *testing.T) {
+	moves := []*Move{
+		{From: "website/docs/d/other.html.markdown", To: filepath.FromSlash("docs/data-sources/other.md")},
+		{From: "website/docs/guides/upgrade.html.md", To: filepath.FromSlash("docs/guides/upgrade.md")},
+		{From: "website/docs/r/thing.html.markdown", To: filepath.FromSlash("docs/resources/thing.md")},
+		{From: "website/docs/r/widget.html.markdown", To: filepath.FromSlash("docs/resources/widget.md")},
+	}
+
+	source := "---\n" +
+		"subcategory: \"Example\"\n" +
+		"layout: \"test\"\n" +
+		"page_title: \"Test: test_thing\"\n" +
+		"sidebar_current: \"docs-test-resource-thing\"\n" +
+		"description: |-\n" +
+		"  Manages a thing.\n" +
+		"---\n" +
+		"\n" +
+		"# Resource: test_thing\n" +
+		"\n" +
+		"Manages a thing. See [`test_widget`](widget.html), the [`test_other` data source](../d/other.html#attributes-reference),\n" +
+		"the [upgrade guide](/docs/providers/test/guides/upgrade.html), [the source](https://example.com/thing.html), and [unknown](missing.html).\n" +
+		"\n" +
+		"```\n" +
+		"[not a link](widget.html)\n" +
+		"```\n"
+
+	want := "---\n" +
+		"subcategory: \"Example\"\n" +
+		"page_title: \"Test: test_thing\"\n" +
+		"description: |-\n" +
+		"  Manages a thing.\n" +
+		"---\n" +
+		"\n" +
+		"# Resource: test_thing\n" +
+		"\n" +
+		"Manages a thing. See [`test_widget`](widget.md), the [`test_other` data source](../data-sources/other.md#attributes-reference),\n" +
+		"the [upgrade guide](/docs/providers/test/guides/upgrade.html), [the source](https://example.com/thing.html), and [unknown](missing.html).\n" +
+		"\n" +
+		"```\n" +
+		"[not a link](widget.html)\n" +
+		"```\n"
+
+	got, err := moves[2].Source([]byte(source), moves)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if string(got) != want {
+		t.Errorf("expected:\n%s\n\ngot:\n%s", want, got)
+	}
+}
@@ -0,0 +1,59 @@
+// Copyright IBM Corp. 2019, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package report
+
+import (
+	"fmt"
+	"io"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+var (
+	githubDataReplacer     = strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A")
+	githubPropertyReplacer = strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A", ":", "%3A", ",", "%2C")
+)
+
+// WriteGitHub writes each finding as a GitHub Actions workflow command, so
+// findings are annotated inline on pull request diffs.
+func (r *Report) WriteGitHub(w io.Writer) error {
+	for _, d := range r.Diagnostics {
+		command := "error"
+
+		var properties []string
+
+		if d.Path != "" {
+			properties = append(properties, "file="+githubPropertyReplacer.Replace(filepath.ToSlash(d.Path)))
+		}
+
+		if d.Line > 0 {
+			properties = append(properties, "line="+strconv.Itoa(d.Line))
+		}
+
+		if d.Column > 0 {
+			properties = append(properties, "col="+strconv.Itoa(d.Column))
+		}
+
+		if d.Rule != "" {
+			properties = append(properties, "title="+githubPropertyReplacer.Replace(d.Rule))
+		}
+
+		message := d.Message
+
+		if d.Suggestion != "" {
+			message += "\nSuggestion: " + d.Suggestion
+		}
+
+		if len(properties) > 0 {
+			command += " " + strings.Join(properties, ",")
+		}
+
+		if _, err := fmt.Fprintf(w, "::%s::%s\n", command, githubDataReplacer.Replace(message)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
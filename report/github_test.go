@@ -0,0 +1,61 @@
+// Copyright IBM Corp. 2019, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package report
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/YakDriver/tfproviderdocs/check/diagnostic"
+)
+
+func TestReportWriteGitHub(t *testing.T) {
+	testCases := []struct {
+		Name       string
+		Diagnostic *diagnostic.Diagnostic
+		Expect     string
+	}{
+		{
+			Name:       "position",
+			Diagnostic: &diagnostic.Diagnostic{Column: 1, Line: 12, Message: "import section heading (Imports) should be: Import", Path: "docs/resources/foo.md", Rule: "import-section", Severity: diagnostic.SeverityError},
+			Expect:     "::error file=docs/resources/foo.md,line=12,col=1,title=import-section::import section heading (Imports) should be: Import\n",
+		},
+		{
+			Name:       "no position",
+			Diagnostic: &diagnostic.Diagnostic{Message: "missing documentation file for resource: test_foo", Rule: "file-missing", Severity: diagnostic.SeverityError},
+			Expect:     "::error title=file-missing::missing documentation file for resource: test_foo\n",
+		},
+		{
+			Name:       "no properties",
+			Diagnostic: &diagnostic.Diagnostic{Message: "test", Severity: diagnostic.SeverityError},
+			Expect:     "::error::test\n",
+		},
+		{
+			Name:       "escaping",
+			Diagnostic: &diagnostic.Diagnostic{Line: 1, Message: "100% wrong\nsecond line", Path: "docs/a,b.md", Rule: "test", Severity: diagnostic.SeverityError},
+			Expect:     "::error file=docs/a%2Cb.md,line=1,title=test::100%25 wrong%0Asecond line\n",
+		},
+		{
+			Name:       "suggestion",
+			Diagnostic: &diagnostic.Diagnostic{Message: "test", Severity: diagnostic.SeverityError, Suggestion: "fix"},
+			Expect:     "::error::test%0ASuggestion: fix\n",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			var buf bytes.Buffer
+
+			r := &Report{Diagnostics: []*diagnostic.Diagnostic{testCase.Diagnostic}}
+
+			if err := r.WriteGitHub(&buf); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got := buf.String(); got != testCase.Expect {
+				t.Errorf("expected %q, got %q", testCase.Expect, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,86 @@
+// Copyright IBM Corp. 2019, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package report
+
+import (
+	"encoding/json"
+	"io"
+	"path/filepath"
+
+	"github.com/YakDriver/tfproviderdocs/check/diagnostic"
+)
+
+// JSONReport represents the JSON report format.
+type JSONReport struct {
+	Summary  JSONSummary   `json:"summary"`
+	Findings []JSONFinding `json:"findings"`
+}
+
+// JSONSummary represents totals of the JSON report format.
+type JSONSummary struct {
+	// Files contains the number of checked files keyed by directory.
+	Files map[string]int `json:"files"`
+
+	// Rules contains the number of findings keyed by rule.
+	Rules map[string]int `json:"rules"`
+
+	// Severities contains the number of findings keyed by severity.
+	Severities map[diagnostic.Severity]int `json:"severities"`
+
+	TotalFiles    int `json:"total_files"`
+	TotalFindings int `json:"total_findings"`
+}
+
+// JSONFinding represents a single finding of the JSON report format.
+type JSONFinding struct {
+	Column     int                 `json:"column,omitempty"`
+	Line       int                 `json:"line,omitempty"`
+	Message    string              `json:"message"`
+	Path       string              `json:"path,omitempty"`
+	Rule       string              `json:"rule,omitempty"`
+	Severity   diagnostic.Severity `json:"severity"`
+	Suggestion string              `json:"suggestion,omitempty"`
+}
+
+// WriteJSON writes the report as a JSON document with a summary and all
+// findings.
+func (r *Report) WriteJSON(w io.Writer) error {
+	result := JSONReport{
+		Summary: JSONSummary{
+			Files:      make(map[string]int, len(r.Directories)),
+			Rules:      make(map[string]int),
+			Severities: make(map[diagnostic.Severity]int),
+		},
+		Findings: make([]JSONFinding, 0, len(r.Diagnostics)),
+	}
+
+	for directory, files := range r.Directories {
+		result.Summary.Files[directory] = len(files)
+		result.Summary.TotalFiles += len(files)
+	}
+
+	for _, d := range r.Diagnostics {
+		if d.Rule != "" {
+			result.Summary.Rules[d.Rule]++
+		}
+
+		result.Summary.Severities[d.Severity]++
+		result.Summary.TotalFindings++
+
+		result.Findings = append(result.Findings, JSONFinding{
+			Column:     d.Column,
+			Line:       d.Line,
+			Message:    d.Message,
+			Path:       filepath.ToSlash(d.Path),
+			Rule:       d.Rule,
+			Severity:   d.Severity,
+			Suggestion: d.Suggestion,
+		})
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(result)
+}
@@ -0,0 +1,60 @@
+// Copyright IBM Corp. 2019, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package report
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/YakDriver/tfproviderdocs/check/diagnostic"
+)
+
+func TestReportWriteJSON(t *testing.T) {
+	var buf bytes.Buffer
+
+	if err := testReport().WriteJSON(&buf); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var got JSONReport
+
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatalf("unexpected error decoding JSON: %s", err)
+	}
+
+	if got.Summary.TotalFiles != 2 || got.Summary.Files["docs/resources"] != 2 {
+		t.Errorf("expected 2 files in docs/resources, got %v", got.Summary.Files)
+	}
+
+	if got.Summary.TotalFindings != 4 || got.Summary.Severities[diagnostic.SeverityError] != 4 {
+		t.Errorf("expected 4 error findings, got %d: %v", got.Summary.TotalFindings, got.Summary.Severities)
+	}
+
+	if got.Summary.Rules["frontmatter"] != 1 {
+		t.Errorf("expected 1 frontmatter finding, got %v", got.Summary.Rules)
+	}
+
+	if len(got.Findings) != 4 {
+		t.Fatalf("expected 4 findings, got %d", len(got.Findings))
+	}
+
+	finding := got.Findings[3]
+
+	if finding.Path != "docs/resources/thing.md" || finding.Line != 12 || finding.Column != 1 || finding.Rule != "import-section" {
+		t.Errorf("unexpected finding: %#v", finding)
+	}
+}
+
+func TestReportWriteJSONEmpty(t *testing.T) {
+	var buf bytes.Buffer
+
+	if err := New(nil, nil, nil, "").WriteJSON(&buf); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !bytes.Contains(buf.Bytes(), []byte(`"findings": []`)) {
+		t.Errorf("expected empty findings array, got: %s", buf.String())
+	}
+}
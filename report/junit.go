@@ -0,0 +1,148 @@
+// Copyright IBM Corp. 2019, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package report
+
+import (
+	"encoding/xml"
+	"io"
+	"maps"
+	"slices"
+
+	"github.com/YakDriver/tfproviderdocs/check/diagnostic"
+)
+
+const (
+	// JUnitGeneralTestSuiteName is the name of the test suite containing
+	// findings which do not belong to a checked documentation file, such as
+	// missing documentation files.
+	JUnitGeneralTestSuiteName = "general"
+)
+
+// JUnit types represent the subset of the JUnit XML report format used by
+// reports.
+
+type junitTestSuites struct {
+	XMLName    xml.Name         `xml:"testsuites"`
+	Name       string           `xml:"name,attr"`
+	Tests      int              `xml:"tests,attr"`
+	Failures   int              `xml:"failures,attr"`
+	TestSuites []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name      string          `xml:"name,attr"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	TestCases []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	ClassName string         `xml:"classname,attr"`
+	Name      string         `xml:"name,attr"`
+	Failures  []junitFailure `xml:"failure"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr,omitempty"`
+	Text    string `xml:",chardata"`
+}
+
+// WriteJUnit writes the report as JUnit XML, with a test suite per
+// documentation directory, a test case per file, and a failure per finding.
+func (r *Report) WriteJUnit(w io.Writer) error {
+	findings := make(map[string][]*diagnostic.Diagnostic)
+
+	for _, d := range r.Diagnostics {
+		findings[d.Path] = append(findings[d.Path], d)
+	}
+
+	result := junitTestSuites{
+		Name: toolName,
+	}
+
+	for _, directory := range slices.Sorted(maps.Keys(r.Directories)) {
+		testSuite := junitTestSuite{
+			Name: directory,
+		}
+
+		// Directory findings, such as invalid directories, are reported
+		// against the directory itself.
+		if diagnostics, ok := findings[directory]; ok {
+			testSuite.TestCases = append(testSuite.TestCases, junitNewTestCase(directory, directory, diagnostics))
+			delete(findings, directory)
+		}
+
+		for _, file := range r.Directories[directory] {
+			testSuite.TestCases = append(testSuite.TestCases, junitNewTestCase(directory, file, findings[file]))
+			delete(findings, file)
+		}
+
+		result.TestSuites = append(result.TestSuites, testSuite)
+	}
+
+	if len(findings) > 0 {
+		testSuite := junitTestSuite{
+			Name: JUnitGeneralTestSuiteName,
+		}
+
+		for _, path := range slices.Sorted(maps.Keys(findings)) {
+			name := path
+
+			if name == "" {
+				name = toolName
+			}
+
+			testSuite.TestCases = append(testSuite.TestCases, junitNewTestCase(JUnitGeneralTestSuiteName, name, findings[path]))
+		}
+
+		result.TestSuites = append(result.TestSuites, testSuite)
+	}
+
+	for i, testSuite := range result.TestSuites {
+		testSuite.Tests = len(testSuite.TestCases)
+
+		for _, testCase := range testSuite.TestCases {
+			if len(testCase.Failures) > 0 {
+				testSuite.Failures++
+			}
+		}
+
+		result.TestSuites[i] = testSuite
+		result.Tests += testSuite.Tests
+		result.Failures += testSuite.Failures
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+
+	if err := encoder.Encode(result); err != nil {
+		return err
+	}
+
+	_, err := io.WriteString(w, "\n")
+
+	return err
+}
+
+func junitNewTestCase(className string, name string, diagnostics []*diagnostic.Diagnostic) junitTestCase {
+	testCase := junitTestCase{
+		ClassName: className,
+		Name:      name,
+	}
+
+	for _, d := range diagnostics {
+		testCase.Failures = append(testCase.Failures, junitFailure{
+			Message: d.Message,
+			Type:    d.Rule,
+			Text:    d.Error(),
+		})
+	}
+
+	return testCase
+}
@@ -0,0 +1,52 @@
+// Copyright IBM Corp. 2019, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package report
+
+import (
+	"bytes"
+	"encoding/xml"
+	"testing"
+)
+
+func TestReportWriteJUnit(t *testing.T) {
+	var buf bytes.Buffer
+
+	if err := testReport().WriteJUnit(&buf); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var got junitTestSuites
+
+	if err := xml.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatalf("unexpected error decoding JUnit XML: %s", err)
+	}
+
+	if got.Tests != 3 || got.Failures != 2 {
+		t.Errorf("expected 3 tests with 2 failures, got %d tests with %d failures", got.Tests, got.Failures)
+	}
+
+	if len(got.TestSuites) != 2 {
+		t.Fatalf("expected 2 test suites, got %d", len(got.TestSuites))
+	}
+
+	resources := got.TestSuites[0]
+
+	if resources.Name != "docs/resources" || resources.Tests != 2 || resources.Failures != 1 {
+		t.Errorf("unexpected test suite: %s with %d tests and %d failures", resources.Name, resources.Tests, resources.Failures)
+	}
+
+	if testCase := resources.TestCases[0]; testCase.Name != "docs/resources/other.md" || len(testCase.Failures) != 0 {
+		t.Errorf("expected passing test case docs/resources/other.md, got %s with %d failures", testCase.Name, len(testCase.Failures))
+	}
+
+	if testCase := resources.TestCases[1]; testCase.Name != "docs/resources/thing.md" || len(testCase.Failures) != 2 {
+		t.Errorf("expected test case docs/resources/thing.md with 2 failures, got %s with %d failures", testCase.Name, len(testCase.Failures))
+	}
+
+	general := got.TestSuites[1]
+
+	if general.Name != JUnitGeneralTestSuiteName || len(general.TestCases) != 1 || len(general.TestCases[0].Failures) != 2 {
+		t.Errorf("expected %s test suite with 1 test case and 2 failures, got: %#v", JUnitGeneralTestSuiteName, general)
+	}
+}
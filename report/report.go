@@ -0,0 +1,88 @@
+// Copyright IBM Corp. 2019, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package report
+
+import (
+	"fmt"
+	"io"
+	"slices"
+
+	"github.com/YakDriver/tfproviderdocs/check/diagnostic"
+)
+
+const (
+	FormatGitHub = "github"
+	FormatJSON   = "json"
+	FormatJUnit  = "junit"
+	FormatSARIF  = "sarif"
+	FormatText   = "text"
+)
+
+// Formats contains all supported report formats.
+var Formats = []string{
+	FormatGitHub,
+	FormatJSON,
+	FormatJUnit,
+	FormatSARIF,
+	FormatText,
+}
+
+// Report represents the findings of checking Terraform Provider documentation.
+type Report struct {
+	// Diagnostics contains every finding, sorted by path and position.
+	Diagnostics []*diagnostic.Diagnostic
+
+	// Directories contains the checked documentation files keyed by
+	// directory, as returned by check.GetDirectories.
+	Directories map[string][]string
+
+	// Rules describes every rule which may report a finding.
+	Rules []diagnostic.Rule
+
+	// Version is the tfproviderdocs version.
+	Version string
+}
+
+// New returns a Report of the findings contained in err.
+func New(err error, directories map[string][]string, rules []diagnostic.Rule, version string) *Report {
+	diagnostics := diagnostic.FromError(err)
+
+	slices.SortStableFunc(diagnostics, diagnostic.Compare)
+
+	return &Report{
+		Diagnostics: diagnostics,
+		Directories: directories,
+		Rules:       rules,
+		Version:     version,
+	}
+}
+
+// Write writes the report to w in the given format.
+func (r *Report) Write(w io.Writer, format string) error {
+	switch format {
+	case FormatGitHub:
+		return r.WriteGitHub(w)
+	case FormatJSON:
+		return r.WriteJSON(w)
+	case FormatJUnit:
+		return r.WriteJUnit(w)
+	case FormatSARIF:
+		return r.WriteSARIF(w)
+	case FormatText:
+		return r.WriteText(w)
+	default:
+		return fmt.Errorf("unsupported report format (%s), should be one of: %v", format, Formats)
+	}
+}
+
+// WriteText writes each finding on its own line.
+func (r *Report) WriteText(w io.Writer) error {
+	for _, d := range r.Diagnostics {
+		if _, err := fmt.Fprintln(w, d.Error()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
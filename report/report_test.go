@@ -0,0 +1,105 @@
+// Copyright IBM Corp. 2019, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package report
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/YakDriver/tfproviderdocs/check/diagnostic"
+	"github.com/hashicorp/go-multierror"
+)
+
+func testReport() *Report {
+	err := multierror.Append(nil,
+		&diagnostic.Diagnostic{Column: 1, Line: 12, Message: "import section heading (Imports) should be: Import", Path: "docs/resources/thing.md", Rule: "import-section", Severity: diagnostic.SeverityError},
+		&diagnostic.Diagnostic{Column: 1, Line: 3, Message: "YAML frontmatter should not contain layout", Path: "docs/resources/thing.md", Rule: "frontmatter", Severity: diagnostic.SeverityError},
+		&diagnostic.Diagnostic{Message: "missing documentation file for resource: test_other", Rule: "file-missing", Severity: diagnostic.SeverityError},
+		errors.New("unexpected error"),
+	)
+
+	directories := map[string][]string{
+		"docs/resources": {"docs/resources/other.md", "docs/resources/thing.md"},
+	}
+
+	rules := []diagnostic.Rule{
+		{ID: "file-missing", Description: "Provider schema names have a documentation file."},
+		{ID: "frontmatter", Description: "YAML frontmatter can be parsed and matches expectations."},
+		{ID: "import-section", Description: "Import section is present."},
+	}
+
+	return New(err, directories, rules, "v0.0.0-test")
+}
+
+func TestNew(t *testing.T) {
+	r := testReport()
+
+	expected := []string{
+		"unexpected error",
+		"missing documentation file for resource: test_other (file-missing)",
+		"docs/resources/thing.md:3:1: YAML frontmatter should not contain layout (frontmatter)",
+		"docs/resources/thing.md:12:1: import section heading (Imports) should be: Import (import-section)",
+	}
+
+	if len(r.Diagnostics) != len(expected) {
+		t.Fatalf("expected %d diagnostics, got %d", len(expected), len(r.Diagnostics))
+	}
+
+	for i, d := range r.Diagnostics {
+		if d.Error() != expected[i] {
+			t.Errorf("expected diagnostic %d %q, got %q", i, expected[i], d.Error())
+		}
+	}
+}
+
+func TestReportWrite(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Format      string
+		ExpectError bool
+	}{
+		{
+			Name:   "github",
+			Format: FormatGitHub,
+		},
+		{
+			Name:   "json",
+			Format: FormatJSON,
+		},
+		{
+			Name:   "junit",
+			Format: FormatJUnit,
+		},
+		{
+			Name:   "sarif",
+			Format: FormatSARIF,
+		},
+		{
+			Name:   "text",
+			Format: FormatText,
+		},
+		{
+			Name:        "unsupported",
+			Format:      "yaml",
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			var buf bytes.Buffer
+
+			got := testReport().Write(&buf, testCase.Format)
+
+			if got == nil && testCase.ExpectError {
+				t.Errorf("expected error, got no error")
+			}
+
+			if got != nil && !testCase.ExpectError {
+				t.Errorf("expected no error, got error: %s", got)
+			}
+		})
+	}
+}
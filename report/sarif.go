@@ -0,0 +1,176 @@
+// Copyright IBM Corp. 2019, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package report
+
+import (
+	"cmp"
+	"encoding/json"
+	"io"
+	"maps"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/YakDriver/tfproviderdocs/check/diagnostic"
+)
+
+const (
+	SARIFSchema  = "https://json.schemastore.org/sarif-2.1.0.json"
+	SARIFVersion = "2.1.0"
+
+	toolInformationURI = "https://github.com/YakDriver/tfproviderdocs"
+	toolName           = "tfproviderdocs"
+)
+
+// SARIF types represent the subset of the Static Analysis Results Interchange
+// Format (SARIF) 2.1.0 log file used by reports.
+
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	InformationURI string                     `json:"informationUri"`
+	Name           string                     `json:"name"`
+	Rules          []sarifReportingDescriptor `json:"rules"`
+	Version        string                     `json:"version,omitempty"`
+}
+
+type sarifReportingDescriptor struct {
+	ID               string       `json:"id"`
+	ShortDescription sarifMessage `json:"shortDescription"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifResult struct {
+	Level     string          `json:"level"`
+	Locations []sarifLocation `json:"locations,omitempty"`
+	Message   sarifMessage    `json:"message"`
+	RuleID    string          `json:"ruleId,omitempty"`
+	RuleIndex *int            `json:"ruleIndex,omitempty"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Region           *sarifRegion          `json:"region,omitempty"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type sarifRegion struct {
+	StartColumn int `json:"startColumn,omitempty"`
+	StartLine   int `json:"startLine"`
+}
+
+// WriteSARIF writes the report as a SARIF 2.1.0 log, with a reporting
+// descriptor per rule and a result per finding.
+func (r *Report) WriteSARIF(w io.Writer) error {
+	driver := sarifDriver{
+		InformationURI: toolInformationURI,
+		Name:           toolName,
+		Rules:          make([]sarifReportingDescriptor, 0, len(r.Rules)),
+		Version:        r.Version,
+	}
+
+	for _, rule := range r.Rules {
+		driver.Rules = append(driver.Rules, sarifReportingDescriptor{
+			ID:               rule.ID,
+			ShortDescription: sarifMessage{Text: rule.Description},
+		})
+	}
+
+	results := make([]sarifResult, 0, len(r.Diagnostics))
+	fallbackURI := r.sarifFallbackURI()
+
+	for _, d := range r.Diagnostics {
+		result := sarifResult{
+			Level:   "error",
+			Message: sarifMessage{Text: d.Message},
+			RuleID:  d.Rule,
+		}
+
+		if d.Suggestion != "" {
+			result.Message.Text += " (suggestion: " + d.Suggestion + ")"
+		}
+
+		if i := slices.IndexFunc(r.Rules, func(rule diagnostic.Rule) bool { return rule.ID == d.Rule }); i >= 0 {
+			result.RuleIndex = &i
+		}
+
+		// Code scanning requires a location for every result, so findings
+		// without a file, such as directory findings, are located at the
+		// documentation directory.
+		location := sarifLocation{
+			PhysicalLocation: sarifPhysicalLocation{
+				ArtifactLocation: sarifArtifactLocation{URI: fallbackURI},
+			},
+		}
+
+		if d.Path != "" {
+			location.PhysicalLocation.ArtifactLocation.URI = filepath.ToSlash(d.Path)
+
+			if d.Line > 0 {
+				location.PhysicalLocation.Region = &sarifRegion{
+					StartColumn: d.Column,
+					StartLine:   d.Line,
+				}
+			}
+		}
+
+		result.Locations = []sarifLocation{location}
+		results = append(results, result)
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(sarifLog{
+		Schema:  SARIFSchema,
+		Version: SARIFVersion,
+		Runs: []sarifRun{
+			{
+				Tool:    sarifTool{Driver: driver},
+				Results: results,
+			},
+		},
+	})
+}
+
+// sarifFallbackURI returns the location of findings without a path, which
+// is the outermost checked documentation directory, or the current directory
+// if there are none.
+func (r *Report) sarifFallbackURI() string {
+	directories := slices.Collect(maps.Keys(r.Directories))
+
+	if len(directories) == 0 {
+		return "."
+	}
+
+	return filepath.ToSlash(slices.MinFunc(directories, func(a, b string) int {
+		return cmp.Or(
+			cmp.Compare(strings.Count(filepath.ToSlash(a), "/"), strings.Count(filepath.ToSlash(b), "/")),
+			cmp.Compare(a, b),
+		)
+	}))
+}
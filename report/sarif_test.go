@@ -0,0 +1,112 @@
+// Copyright IBM Corp. 2019, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package report
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+)
+
+func TestReportWriteSARIF(t *testing.T) {
+	var buf bytes.Buffer
+
+	if err := testReport().WriteSARIF(&buf); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var got sarifLog
+
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatalf("unexpected error decoding SARIF: %s", err)
+	}
+
+	if got.Version != SARIFVersion {
+		t.Errorf("expected version %s, got %s", SARIFVersion, got.Version)
+	}
+
+	if len(got.Runs) != 1 {
+		t.Fatalf("expected 1 run, got %d", len(got.Runs))
+	}
+
+	run := got.Runs[0]
+
+	if len(run.Tool.Driver.Rules) != 3 {
+		t.Errorf("expected 3 rules, got %d", len(run.Tool.Driver.Rules))
+	}
+
+	if len(run.Results) != 4 {
+		t.Fatalf("expected 4 results, got %d", len(run.Results))
+	}
+
+	result := run.Results[3]
+
+	if result.RuleID != "import-section" {
+		t.Errorf("expected rule import-section, got %s", result.RuleID)
+	}
+
+	if result.RuleIndex == nil || *result.RuleIndex != 2 {
+		t.Errorf("expected rule index 2, got %v", result.RuleIndex)
+	}
+
+	if len(result.Locations) != 1 {
+		t.Fatalf("expected 1 location, got %d", len(result.Locations))
+	}
+
+	location := result.Locations[0].PhysicalLocation
+
+	if location.ArtifactLocation.URI != "docs/resources/thing.md" {
+		t.Errorf("expected uri docs/resources/thing.md, got %s", location.ArtifactLocation.URI)
+	}
+
+	if location.Region == nil || location.Region.StartLine != 12 || location.Region.StartColumn != 1 {
+		t.Errorf("expected region 12:1, got %v", location.Region)
+	}
+
+	if len(run.Results[1].Locations) != 1 {
+		t.Fatalf("expected 1 location for finding without path, got %v", run.Results[1].Locations)
+	}
+
+	if got, want := run.Results[1].Locations[0].PhysicalLocation.ArtifactLocation.URI, "docs/resources"; got != want {
+		t.Errorf("expected fallback uri %s for finding without path, got %s", want, got)
+	}
+
+	if run.Results[1].Locations[0].PhysicalLocation.Region != nil {
+		t.Errorf("expected no region for finding without path, got %v", run.Results[1].Locations[0].PhysicalLocation.Region)
+	}
+}
+
+func TestReportSARIFFallbackURI(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Directories map[string][]string
+		Expect      string
+	}{
+		{
+			Name:   "no directories",
+			Expect: ".",
+		},
+		{
+			Name: "outermost directory",
+			Directories: map[string][]string{
+				"docs/resources":      nil,
+				"website/docs":        nil,
+				"docs":                nil,
+				"website/docs/r":      nil,
+				"docs/cdktf/python/r": nil,
+			},
+			Expect: "docs",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			r := &Report{Directories: testCase.Directories}
+
+			if got := r.sarifFallbackURI(); got != testCase.Expect {
+				t.Errorf("expected %s, got %s", testCase.Expect, got)
+			}
+		})
+	}
+}
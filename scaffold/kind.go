@@ -0,0 +1,90 @@
+// Copyright IBM Corp. 2019, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package scaffold
+
+import (
+	"github.com/YakDriver/tfproviderdocs/check"
+)
+
+const (
+	LayoutLegacy   = "legacy"
+	LayoutRegistry = "registry"
+)
+
+// Kind represents a kind of documentation page, such as a resource or a data
+// source, and which sections its page includes.
+type Kind struct {
+	*check.Kind
+
+	// BlockType is the Terraform configuration block type of examples, e.g.
+	// resource. Empty for functions.
+	BlockType string
+
+	// ConfigBlock places example arguments in a nested config block.
+	ConfigBlock bool
+
+	// ProviderArgument adds the provider meta-argument to examples.
+	ProviderArgument bool
+
+	TitlePrefix string
+
+	Attributes bool
+	Import     bool
+	Timeouts   bool
+}
+
+var (
+	KindAction = &Kind{
+		Kind:        check.KindAction,
+		BlockType:   "action",
+		ConfigBlock: true,
+		TitlePrefix: "Action",
+	}
+
+	KindDataSource = &Kind{
+		Kind:        check.KindDataSource,
+		BlockType:   "data",
+		TitlePrefix: "Data Source",
+		Attributes:  true,
+	}
+
+	KindEphemeral = &Kind{
+		Kind:        check.KindEphemeral,
+		BlockType:   "ephemeral",
+		TitlePrefix: "Ephemeral",
+		Attributes:  true,
+	}
+
+	KindFunction = &Kind{
+		Kind:        check.KindFunction,
+		TitlePrefix: "Function",
+	}
+
+	KindListResource = &Kind{
+		Kind:             check.KindListResource,
+		BlockType:        "list",
+		ConfigBlock:      true,
+		ProviderArgument: true,
+		TitlePrefix:      "List Resource",
+	}
+
+	KindResource = &Kind{
+		Kind:        check.KindResource,
+		BlockType:   "resource",
+		TitlePrefix: "Resource",
+		Attributes:  true,
+		Import:      true,
+		Timeouts:    true,
+	}
+)
+
+// Kinds contains every kind of documentation page which can be scaffolded.
+var Kinds = []*Kind{
+	KindAction,
+	KindDataSource,
+	KindEphemeral,
+	KindFunction,
+	KindListResource,
+	KindResource,
+}
@@ -0,0 +1,509 @@
+// Copyright IBM Corp. 2019, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package scaffold
+
+import (
+	"bytes"
+	"fmt"
+	"maps"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/YakDriver/tfproviderdocs/check"
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// TimeoutsBlockName is the nested block name of operation timeouts, which is
+// documented in the Timeouts section instead of the arguments.
+const TimeoutsBlockName = "timeouts"
+
+// Page represents a documentation page to scaffold.
+type Page struct {
+	// Function is the function signature of function pages.
+	Function *tfjson.FunctionSignature
+
+	Kind         *Kind
+	Layout       string
+	Name         string
+	ProviderName string
+
+	// Schema is the schema block of all kinds except functions.
+	Schema *tfjson.SchemaBlock
+
+	// Subcategory is the frontmatter subcategory, which is left empty for
+	// filling in if not given.
+	Subcategory string
+}
+
+// Path returns the documentation file path of the page, relative to the
+// provider root directory.
+func (p *Page) Path() string {
+	fileName := p.Name
+
+	if p.Kind != KindFunction {
+		fileName = strings.TrimPrefix(fileName, p.ProviderName+"_")
+	}
+
+	if p.Layout == LayoutLegacy {
+		return filepath.Join(check.LegacyIndexDirectory, p.Kind.LegacyDirectory, fileName+".html.markdown")
+	}
+
+	return filepath.Join(check.RegistryIndexDirectory, p.Kind.RegistryDirectory, fileName+".md")
+}
+
+// Render returns the Markdown contents of the page.
+func (p *Page) Render() []byte {
+	var b bytes.Buffer
+
+	p.writeFrontMatter(&b)
+
+	fmt.Fprintf(&b, "# %s: %s\n\n", p.Kind.TitlePrefix, p.Name)
+	fmt.Fprintf(&b, "%s\n\n", p.description())
+
+	if p.Schema != nil && p.Schema.Deprecated {
+		fmt.Fprintf(&b, "~> **Warning:** This %s is deprecated.\n\n", p.Kind.Noun)
+	}
+
+	b.WriteString("## Example Usage\n\n")
+	b.WriteString("```terraform\n")
+	p.writeExample(&b)
+	b.WriteString("```\n")
+
+	if p.Kind == KindFunction {
+		p.writeFunctionSections(&b)
+
+		return b.Bytes()
+	}
+
+	p.writeArgumentsSection(&b)
+
+	if p.Kind.Attributes {
+		p.writeAttributesSection(&b)
+	}
+
+	if p.Kind.Timeouts {
+		p.writeTimeoutsSection(&b)
+	}
+
+	if p.Kind.Import {
+		p.writeImportSection(&b)
+	}
+
+	return b.Bytes()
+}
+
+func (p *Page) description() string {
+	var description string
+
+	switch {
+	case p.Function != nil && p.Function.Summary != "":
+		description = p.Function.Summary
+	case p.Function != nil:
+		description = p.Function.Description
+	case p.Schema != nil:
+		description = p.Schema.Description
+	}
+
+	return sentence(description, fmt.Sprintf("TODO: Describe the %s %s", p.Name, p.Kind.Noun))
+}
+
+func (p *Page) writeFrontMatter(b *bytes.Buffer) {
+	b.WriteString("---\n")
+
+	fmt.Fprintf(b, "subcategory: %q\n", p.Subcategory)
+
+	if p.Layout == LayoutLegacy {
+		fmt.Fprintf(b, "layout: %q\n", p.ProviderName)
+	}
+
+	fmt.Fprintf(b, "page_title: %q\n", fmt.Sprintf("%s: %s", titleCase(p.ProviderName), p.Name))
+	fmt.Fprintf(b, "description: |-\n  %s\n", p.description())
+	b.WriteString("---\n\n")
+}
+
+func (p *Page) writeExample(b *bytes.Buffer) {
+	if p.Kind == KindFunction {
+		var arguments []string
+
+		if p.Function != nil {
+			for _, parameter := range p.Function.Parameters {
+				arguments = append(arguments, exampleValue(parameter.Type))
+			}
+		}
+
+		fmt.Fprintf(b, "output \"example\" {\n  value = provider::%s::%s(%s)\n}\n", p.ProviderName, p.Name, strings.Join(arguments, ", "))
+
+		return
+	}
+
+	fmt.Fprintf(b, "%s %q \"example\" {\n", p.Kind.BlockType, p.Name)
+
+	if p.Kind.ProviderArgument {
+		fmt.Fprintf(b, "  provider = %s\n", p.ProviderName)
+	}
+
+	if p.Kind.ConfigBlock {
+		if p.Kind.ProviderArgument {
+			b.WriteString("\n")
+		}
+
+		b.WriteString("  config {\n")
+		writeExampleArguments(b, p.Schema, "    ")
+		b.WriteString("  }\n")
+	} else {
+		writeExampleArguments(b, p.Schema, "  ")
+	}
+
+	b.WriteString("}\n")
+}
+
+// writeExampleArguments writes the required arguments and required nested
+// blocks of the schema block.
+func writeExampleArguments(b *bytes.Buffer, block *tfjson.SchemaBlock, indent string) {
+	if block == nil {
+		return
+	}
+
+	for _, name := range argumentNames(block) {
+		if attribute, ok := block.Attributes[name]; ok {
+			if attribute.Required {
+				fmt.Fprintf(b, "%s%s = %s\n", indent, name, exampleAttributeValue(attribute))
+			}
+
+			continue
+		}
+
+		if nestedBlock := block.NestedBlocks[name]; nestedBlock != nil && nestedBlock.MinItems > 0 {
+			fmt.Fprintf(b, "%s%s {\n", indent, name)
+			writeExampleArguments(b, nestedBlock.Block, indent+"  ")
+			fmt.Fprintf(b, "%s}\n", indent)
+		}
+	}
+}
+
+func (p *Page) writeArgumentsSection(b *bytes.Buffer) {
+	b.WriteString("\n## Argument Reference\n\n")
+
+	names := argumentNames(p.Schema)
+
+	if len(names) == 0 {
+		fmt.Fprintf(b, "This %s does not support any arguments.\n", p.Kind.Noun)
+
+		return
+	}
+
+	fmt.Fprintf(b, "This %s supports the following arguments:\n\n", p.Kind.Noun)
+	writeArgumentsList(b, p.Schema)
+	writeNestedBlockSections(b, p.Schema, 3)
+}
+
+// writeArgumentsList writes a list item for each argument and nested block of
+// the schema block.
+func writeArgumentsList(b *bytes.Buffer, block *tfjson.SchemaBlock) {
+	for _, name := range argumentNames(block) {
+		if attribute, ok := block.Attributes[name]; ok {
+			fmt.Fprintf(b, "* `%s` - (%s) %s%s\n", name, requiredOrOptional(attribute.Required), deprecatedMarker(attribute.Deprecated), sentence(attribute.Description, "TODO: Describe this argument"))
+
+			continue
+		}
+
+		var deprecated bool
+		var description string
+		var required bool
+
+		nestedBlock := block.NestedBlocks[name]
+
+		if nestedBlock != nil {
+			required = nestedBlock.MinItems > 0
+
+			if nestedBlock.Block != nil {
+				deprecated = nestedBlock.Block.Deprecated
+				description = nestedBlock.Block.Description
+			}
+		}
+
+		description = sentence(description, "TODO: Describe this configuration block")
+
+		if nestedBlock != nil && len(argumentNames(nestedBlock.Block)) > 0 {
+			description += " See below."
+		}
+
+		fmt.Fprintf(b, "* `%s` - (%s) %s%s\n", name, requiredOrOptional(required), deprecatedMarker(deprecated), description)
+	}
+}
+
+// writeNestedBlockSections writes a section at the heading level for each
+// nested block with arguments of the schema block, followed by the sections
+// of its own nested blocks one level deeper.
+func writeNestedBlockSections(b *bytes.Buffer, block *tfjson.SchemaBlock, level int) {
+	for _, name := range argumentNames(block) {
+		nestedBlock, ok := block.NestedBlocks[name]
+
+		if !ok || len(argumentNames(nestedBlock.Block)) == 0 {
+			continue
+		}
+
+		fmt.Fprintf(b, "\n%s `%s` Configuration Block\n\n", strings.Repeat("#", level), name)
+		fmt.Fprintf(b, "The `%s` configuration block supports the following arguments:\n\n", name)
+		writeArgumentsList(b, nestedBlock.Block)
+		writeNestedBlockSections(b, nestedBlock.Block, level+1)
+	}
+}
+
+func (p *Page) writeAttributesSection(b *bytes.Buffer) {
+	b.WriteString("\n## Attribute Reference\n\n")
+
+	names := attributeNames(p.Schema)
+
+	if len(names) == 0 {
+		fmt.Fprintf(b, "This %s exports no additional attributes.\n", p.Kind.Noun)
+
+		return
+	}
+
+	fmt.Fprintf(b, "This %s exports the following attributes in addition to the arguments above:\n\n", p.Kind.Noun)
+
+	for _, name := range names {
+		attribute := p.Schema.Attributes[name]
+
+		fmt.Fprintf(b, "* `%s` - %s%s\n", name, deprecatedMarker(attribute.Deprecated), sentence(attribute.Description, "TODO: Describe this attribute"))
+	}
+}
+
+func (p *Page) writeTimeoutsSection(b *bytes.Buffer) {
+	if p.Schema == nil {
+		return
+	}
+
+	timeouts, ok := p.Schema.NestedBlocks[TimeoutsBlockName]
+
+	if !ok || timeouts.Block == nil {
+		return
+	}
+
+	b.WriteString("\n## Timeouts\n\n")
+	fmt.Fprintf(b, "`%s` provides the following [Timeouts](/docs/configuration/resources.html#timeouts)\nconfiguration options:\n\n", p.Name)
+
+	for _, name := range slices.Sorted(maps.Keys(timeouts.Block.Attributes)) {
+		fmt.Fprintf(b, "* `%s` - How long to wait for the %s operation.\n", name, name)
+	}
+}
+
+func (p *Page) writeImportSection(b *bytes.Buffer) {
+	b.WriteString("\n## Import\n\n")
+	fmt.Fprintf(b, "Import `%s` resources using the `id`. For example:\n\n", p.Name)
+	b.WriteString("```terraform\n")
+	fmt.Fprintf(b, "import {\n  to = %s.example\n  id = \"example\"\n}\n", p.Name)
+	b.WriteString("```\n")
+}
+
+func (p *Page) writeFunctionSections(b *bytes.Buffer) {
+	var parameters []*tfjson.FunctionParameter
+	var variadic bool
+	returnType := cty.DynamicPseudoType
+
+	if p.Function != nil {
+		parameters = slices.Clone(p.Function.Parameters)
+		returnType = p.Function.ReturnType
+
+		if v := p.Function.VariadicParameter; v != nil {
+			parameters = append(parameters, v)
+			variadic = true
+		}
+	}
+
+	signature := make([]string, len(parameters))
+
+	for i, parameter := range parameters {
+		format := "%s %s"
+
+		if variadic && i == len(parameters)-1 {
+			format = "%s ...%s"
+		}
+
+		signature[i] = fmt.Sprintf(format, parameterName(parameter, i), typeName(parameter.Type))
+	}
+
+	b.WriteString("\n## Signature\n\n")
+	b.WriteString("```text\n")
+	fmt.Fprintf(b, "%s(%s) %s\n", p.Name, strings.Join(signature, ", "), typeName(returnType))
+	b.WriteString("```\n")
+
+	b.WriteString("\n## Arguments\n\n")
+
+	if len(parameters) == 0 {
+		b.WriteString("This function does not support any arguments.\n")
+
+		return
+	}
+
+	for i, parameter := range parameters {
+		fmt.Fprintf(b, "%d. `%s` (%s) %s\n", i+1, parameterName(parameter, i), titleCase(typeName(parameter.Type)), sentence(parameter.Description, "TODO: Describe this argument"))
+	}
+}
+
+// argumentNames returns the sorted names of configurable schema attributes and
+// nested blocks, except id and timeouts.
+func argumentNames(block *tfjson.SchemaBlock) []string {
+	if block == nil {
+		return nil
+	}
+
+	var names []string
+
+	for name, attribute := range block.Attributes {
+		// Terraform Plugin SDK schemas mark id as Optional, but it cannot be configured.
+		if name == "id" || (!attribute.Required && !attribute.Optional) {
+			continue
+		}
+
+		names = append(names, name)
+	}
+
+	for name := range block.NestedBlocks {
+		if name == TimeoutsBlockName {
+			continue
+		}
+
+		names = append(names, name)
+	}
+
+	slices.Sort(names)
+
+	return names
+}
+
+// attributeNames returns the sorted names of computed-only schema attributes
+// and id.
+func attributeNames(block *tfjson.SchemaBlock) []string {
+	if block == nil {
+		return nil
+	}
+
+	var names []string
+
+	for name, attribute := range block.Attributes {
+		if (name == "id" && !attribute.Required) || (attribute.Computed && !attribute.Optional && !attribute.Required) {
+			names = append(names, name)
+		}
+	}
+
+	slices.Sort(names)
+
+	return names
+}
+
+// exampleAttributeValue returns a placeholder configuration value of the
+// attribute. Nested attributes are given an object, or a list of one object,
+// of their required attributes.
+func exampleAttributeValue(attribute *tfjson.SchemaAttribute) string {
+	nestedType := attribute.AttributeNestedType
+
+	if nestedType == nil {
+		return exampleValue(attribute.AttributeType)
+	}
+
+	switch nestedType.NestingMode {
+	case tfjson.SchemaNestingModeList, tfjson.SchemaNestingModeSet:
+		return "[" + exampleObjectValue(nestedType.Attributes) + "]"
+	case tfjson.SchemaNestingModeMap:
+		return "{}"
+	default:
+		return exampleObjectValue(nestedType.Attributes)
+	}
+}
+
+// exampleObjectValue returns a placeholder object of the required attributes.
+func exampleObjectValue(attributes map[string]*tfjson.SchemaAttribute) string {
+	var items []string
+
+	for _, name := range slices.Sorted(maps.Keys(attributes)) {
+		if attribute := attributes[name]; attribute.Required {
+			items = append(items, fmt.Sprintf("%s = %s", name, exampleAttributeValue(attribute)))
+		}
+	}
+
+	if len(items) == 0 {
+		return "{}"
+	}
+
+	return "{ " + strings.Join(items, ", ") + " }"
+}
+
+// exampleValue returns a placeholder configuration value of the type.
+func exampleValue(t cty.Type) string {
+	switch {
+	case t == cty.String:
+		return `"example"`
+	case t == cty.Number:
+		return "1"
+	case t == cty.Bool:
+		return "true"
+	case t.IsListType(), t.IsSetType(), t.IsTupleType():
+		return "[]"
+	case t.IsMapType(), t.IsObjectType():
+		return "{}"
+	default:
+		return "null"
+	}
+}
+
+func parameterName(parameter *tfjson.FunctionParameter, i int) string {
+	if parameter.Name != "" {
+		return parameter.Name
+	}
+
+	return fmt.Sprintf("arg%d", i+1)
+}
+
+func requiredOrOptional(required bool) string {
+	if required {
+		return "Required"
+	}
+
+	return "Optional"
+}
+
+// deprecatedMarker returns the description prefix of deprecated arguments
+// and attributes.
+func deprecatedMarker(deprecated bool) string {
+	if deprecated {
+		return "**Deprecated** "
+	}
+
+	return ""
+}
+
+// sentence returns the text, or the fallback if empty, ending with a period.
+func sentence(text string, fallback string) string {
+	text = strings.Join(strings.Fields(text), " ")
+
+	if text == "" {
+		text = fallback
+	}
+
+	if !strings.HasSuffix(text, ".") {
+		text += "."
+	}
+
+	return text
+}
+
+func titleCase(s string) string {
+	if s == "" {
+		return s
+	}
+
+	return strings.ToUpper(s[:1]) + s[1:]
+}
+
+func typeName(t cty.Type) string {
+	if t == cty.NilType {
+		return "dynamic"
+	}
+
+	return t.FriendlyName()
+}
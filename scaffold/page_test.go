@@ -0,0 +1,445 @@
+// Copyright IBM Corp. 2019, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package scaffold
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/YakDriver/tfproviderdocs/check"
+	"github.com/YakDriver/tfproviderdocs/check/contents"
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/zclconf/go-cty/cty"
+)
+
+func testSchemaBlock() *tfjson.SchemaBlock {
+	return &tfjson.SchemaBlock{
+		Attributes: map[string]*tfjson.SchemaAttribute{
+			"arn": {
+				AttributeType: cty.String,
+				Computed:      true,
+				Description:   "ARN of the thing",
+			},
+			"id": {
+				AttributeType: cty.String,
+				Computed:      true,
+				Optional:      true,
+			},
+			"name": {
+				AttributeType: cty.String,
+				Required:      true,
+			},
+			"tags": {
+				AttributeType: cty.Map(cty.String),
+				Optional:      true,
+			},
+		},
+		NestedBlocks: map[string]*tfjson.SchemaBlockType{
+			"rule": {
+				Block: &tfjson.SchemaBlock{
+					Attributes: map[string]*tfjson.SchemaAttribute{
+						"priority": {
+							AttributeType: cty.Number,
+							Required:      true,
+						},
+					},
+				},
+				MinItems: 1,
+			},
+			"timeouts": {
+				Block: &tfjson.SchemaBlock{
+					Attributes: map[string]*tfjson.SchemaAttribute{
+						"create": {
+							AttributeType: cty.String,
+							Optional:      true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// testNestedAttributeSchemaBlock returns a schema with required nested
+// attributes, which have no cty type of their own.
+func testNestedAttributeSchemaBlock() *tfjson.SchemaBlock {
+	return &tfjson.SchemaBlock{
+		Attributes: map[string]*tfjson.SchemaAttribute{
+			"id": {
+				AttributeType: cty.String,
+				Computed:      true,
+			},
+			"settings": {
+				AttributeNestedType: &tfjson.SchemaNestedAttributeType{
+					Attributes: map[string]*tfjson.SchemaAttribute{
+						"label": {
+							AttributeType: cty.String,
+							Optional:      true,
+						},
+						"mode": {
+							AttributeType: cty.String,
+							Required:      true,
+						},
+					},
+					NestingMode: tfjson.SchemaNestingModeSingle,
+				},
+				Required: true,
+			},
+			"targets": {
+				AttributeNestedType: &tfjson.SchemaNestedAttributeType{
+					Attributes: map[string]*tfjson.SchemaAttribute{
+						"arn": {
+							AttributeType: cty.String,
+							Required:      true,
+						},
+					},
+					NestingMode: tfjson.SchemaNestingModeList,
+				},
+				Required: true,
+			},
+		},
+	}
+}
+
+func TestPagePath(t *testing.T) {
+	testCases := []struct {
+		Name       string
+		Page       *Page
+		ExpectPath string
+	}{
+		{
+			Name: "registry resource",
+			Page: &Page{
+				Kind:         KindResource,
+				Layout:       LayoutRegistry,
+				Name:         "test_thing",
+				ProviderName: "test",
+			},
+			ExpectPath: "docs/resources/thing.md",
+		},
+		{
+			Name: "legacy data source",
+			Page: &Page{
+				Kind:         KindDataSource,
+				Layout:       LayoutLegacy,
+				Name:         "test_thing",
+				ProviderName: "test",
+			},
+			ExpectPath: "website/docs/d/thing.html.markdown",
+		},
+		{
+			Name: "registry function",
+			Page: &Page{
+				Kind:         KindFunction,
+				Layout:       LayoutRegistry,
+				Name:         "test_parse",
+				ProviderName: "test",
+			},
+			ExpectPath: "docs/functions/test_parse.md",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			if got, want := testCase.Page.Path(), filepath.FromSlash(testCase.ExpectPath); got != want {
+				t.Errorf("expected %s, got %s", want, got)
+			}
+		})
+	}
+}
+
+func TestPageRender(t *testing.T) {
+	testCases := []struct {
+		Name    string
+		Page    *Page
+		Options *check.ContentsOptions
+		Run     func(*check.FileOptions, *check.ContentsOptions, string) error
+	}{
+		{
+			Name: "registry resource",
+			Page: &Page{
+				Kind:   KindResource,
+				Layout: LayoutRegistry,
+				Schema: testSchemaBlock(),
+			},
+			Options: &check.ContentsOptions{
+				RequireAttributesSection: contents.Required,
+				RequireSchemaOrdering:    true,
+			},
+			Run: func(fileOpts *check.FileOptions, contentsOpts *check.ContentsOptions, path string) error {
+				return check.NewRegistryResourceFileCheck(&check.RegistryResourceFileOptions{Contents: contentsOpts, FileOptions: fileOpts, ProviderName: "test"}).Run(path, "terraform")
+			},
+		},
+		{
+			Name: "legacy resource",
+			Page: &Page{
+				Kind:        KindResource,
+				Layout:      LayoutLegacy,
+				Schema:      testSchemaBlock(),
+				Subcategory: "Example",
+			},
+			Options: &check.ContentsOptions{
+				RequireAttributesSection: contents.Required,
+			},
+			Run: func(fileOpts *check.FileOptions, contentsOpts *check.ContentsOptions, path string) error {
+				return check.NewLegacyResourceFileCheck(&check.LegacyResourceFileOptions{Contents: contentsOpts, FileOptions: fileOpts, ProviderName: "test"}).Run(path, "terraform")
+			},
+		},
+		{
+			Name: "registry deprecated resource",
+			Page: &Page{
+				Kind:   KindResource,
+				Layout: LayoutRegistry,
+				Schema: &tfjson.SchemaBlock{
+					Attributes: map[string]*tfjson.SchemaAttribute{
+						"arn": {
+							AttributeType: cty.String,
+							Computed:      true,
+						},
+						"old_arn": {
+							AttributeType: cty.String,
+							Computed:      true,
+							Deprecated:    true,
+						},
+						"old_name": {
+							AttributeType: cty.String,
+							Deprecated:    true,
+							Optional:      true,
+						},
+					},
+					Deprecated: true,
+				},
+			},
+			Options: &check.ContentsOptions{
+				RequireAttributesSection: contents.Required,
+			},
+			Run: func(fileOpts *check.FileOptions, contentsOpts *check.ContentsOptions, path string) error {
+				return check.NewRegistryResourceFileCheck(&check.RegistryResourceFileOptions{Contents: contentsOpts, FileOptions: fileOpts, ProviderName: "test"}).Run(path, "terraform")
+			},
+		},
+		{
+			Name: "registry nested attribute resource",
+			Page: &Page{
+				Kind:   KindResource,
+				Layout: LayoutRegistry,
+				Schema: testNestedAttributeSchemaBlock(),
+			},
+			Options: &check.ContentsOptions{
+				RequireAttributesSection: contents.Required,
+			},
+			Run: func(fileOpts *check.FileOptions, contentsOpts *check.ContentsOptions, path string) error {
+				return check.NewRegistryResourceFileCheck(&check.RegistryResourceFileOptions{Contents: contentsOpts, FileOptions: fileOpts, ProviderName: "test"}).Run(path, "terraform")
+			},
+		},
+		{
+			Name: "registry data source",
+			Page: &Page{
+				Kind:   KindDataSource,
+				Layout: LayoutRegistry,
+				Schema: testSchemaBlock(),
+			},
+			Options: &check.ContentsOptions{
+				RequireAttributesSection: contents.Required,
+				RequireImportSection:     contents.Forbidden,
+				TitleSectionPrefixes:     []string{"Data Source"},
+			},
+			Run: func(fileOpts *check.FileOptions, contentsOpts *check.ContentsOptions, path string) error {
+				return check.NewRegistryDataSourceFileCheck(&check.RegistryDataSourceFileOptions{Contents: contentsOpts, FileOptions: fileOpts}).Run(path, "terraform")
+			},
+		},
+		{
+			Name: "registry ephemeral",
+			Page: &Page{
+				Kind:   KindEphemeral,
+				Layout: LayoutRegistry,
+				Schema: &tfjson.SchemaBlock{},
+			},
+			Options: &check.ContentsOptions{
+				RequireAttributesSection: contents.Required,
+				RequireImportSection:     contents.Forbidden,
+				TitleSectionPrefixes:     []string{"Ephemeral"},
+			},
+			Run: func(fileOpts *check.FileOptions, contentsOpts *check.ContentsOptions, path string) error {
+				return check.NewRegistryEphemeralFileCheck(&check.RegistryEphemeralFileOptions{Contents: contentsOpts, FileOptions: fileOpts, ProviderName: "test"}).Run(path, "terraform")
+			},
+		},
+		{
+			Name: "registry list resource",
+			Page: &Page{
+				Kind:   KindListResource,
+				Layout: LayoutRegistry,
+				Schema: &tfjson.SchemaBlock{
+					Attributes: map[string]*tfjson.SchemaAttribute{
+						"filter": {
+							AttributeType: cty.String,
+							Optional:      true,
+						},
+					},
+				},
+			},
+			Options: &check.ContentsOptions{
+				RequireAttributesSection: contents.Forbidden,
+				RequireTimeoutsSection:   contents.Forbidden,
+				RequireImportSection:     contents.Forbidden,
+				RequireSchemaOrdering:    true,
+				TitleSectionPrefixes:     []string{"List Resource"},
+			},
+			Run: func(fileOpts *check.FileOptions, contentsOpts *check.ContentsOptions, path string) error {
+				return check.NewRegistryListResourceFileCheck(&check.RegistryListResourceFileOptions{Contents: contentsOpts, FileOptions: fileOpts, ProviderName: "test"}).Run(path, "terraform")
+			},
+		},
+		{
+			Name: "registry action",
+			Page: &Page{
+				Kind:   KindAction,
+				Layout: LayoutRegistry,
+				Schema: &tfjson.SchemaBlock{
+					Attributes: map[string]*tfjson.SchemaAttribute{
+						"example": {
+							AttributeType: cty.Bool,
+							Required:      true,
+						},
+					},
+				},
+			},
+			Options: &check.ContentsOptions{
+				DisableRegionArgumentCheck: true,
+				DisallowAttributesSection:  true,
+				DisallowImportSection:      true,
+				TitleSectionPrefixes:       []string{"Action"},
+			},
+			Run: func(fileOpts *check.FileOptions, contentsOpts *check.ContentsOptions, path string) error {
+				return check.NewRegistryActionFileCheck(&check.RegistryActionFileOptions{Contents: contentsOpts, FileOptions: fileOpts, ProviderName: "test"}).Run(path, "terraform")
+			},
+		},
+		{
+			Name: "registry function",
+			Page: &Page{
+				Function: &tfjson.FunctionSignature{
+					Parameters: []*tfjson.FunctionParameter{
+						{
+							Name: "input",
+							Type: cty.String,
+						},
+					},
+					ReturnType: cty.Bool,
+					Summary:    "Parses the input",
+				},
+				Kind:   KindFunction,
+				Layout: LayoutRegistry,
+			},
+			Options: &check.ContentsOptions{
+				ArgumentsHeadingTexts:       []string{"Arguments"},
+				AllowArgumentsMissingByline: true,
+				RequireImportSection:        contents.Forbidden,
+				RequireSignatureSection:     contents.Required,
+				SignatureHeadingTexts:       []string{"Signature"},
+				SignatureRequiresCodeBlock:  true,
+				TitleSectionPrefixes:        []string{"Function"},
+			},
+			Run: func(fileOpts *check.FileOptions, contentsOpts *check.ContentsOptions, path string) error {
+				return check.NewRegistryFunctionFileCheck(&check.RegistryFunctionFileOptions{Contents: contentsOpts, FileOptions: fileOpts, ProviderName: "test"}).Run(path, "terraform")
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			page := testCase.Page
+			page.ProviderName = "test"
+
+			if page.Name == "" {
+				page.Name = "test_thing"
+			}
+
+			dir := t.TempDir()
+			path := page.Path()
+
+			if err := os.MkdirAll(filepath.Join(dir, filepath.Dir(path)), 0755); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if err := os.WriteFile(filepath.Join(dir, path), page.Render(), 0644); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			contentsOpts := testCase.Options
+			contentsOpts.Enable = true
+			contentsOpts.ProviderName = "test"
+
+			if page.Schema != nil {
+				contentsOpts.Schemas = map[string]*tfjson.SchemaBlock{page.Name: page.Schema}
+			}
+
+			if err := testCase.Run(&check.FileOptions{BasePath: dir}, contentsOpts, path); err != nil {
+				t.Errorf("expected scaffolded page to pass checks, got error: %s\n\n%s", err, page.Render())
+			}
+		})
+	}
+}
+
+func TestPageRenderExample(t *testing.T) {
+	page := &Page{
+		Kind:         KindResource,
+		Layout:       LayoutRegistry,
+		Name:         "test_thing",
+		ProviderName: "test",
+		Schema:       testSchemaBlock(),
+	}
+
+	want := `resource "test_thing" "example" {
+  name = "example"
+  rule {
+    priority = 1
+  }
+}`
+
+	if got := string(page.Render()); !strings.Contains(got, want) {
+		t.Errorf("expected example:\n%s\n\ngot:\n%s", want, got)
+	}
+}
+
+func TestPageRenderExampleNestedAttribute(t *testing.T) {
+	page := &Page{
+		Kind:         KindResource,
+		Layout:       LayoutRegistry,
+		Name:         "test_thing",
+		ProviderName: "test",
+		Schema:       testNestedAttributeSchemaBlock(),
+	}
+
+	want := `resource "test_thing" "example" {
+  settings = { mode = "example" }
+  targets = [{ arn = "example" }]
+}`
+
+	if got := string(page.Render()); !strings.Contains(got, want) {
+		t.Errorf("expected example:\n%s\n\ngot:\n%s", want, got)
+	}
+}
+
+func TestPageRenderNestedBlockSections(t *testing.T) {
+	page := &Page{
+		Kind:         KindResource,
+		Layout:       LayoutRegistry,
+		Name:         "test_thing",
+		ProviderName: "test",
+		Schema:       testSchemaBlock(),
+	}
+
+	want := "* `rule` - (Required) TODO: Describe this configuration block. See below.\n" +
+		"* `tags` - (Optional) TODO: Describe this argument.\n" +
+		"\n" +
+		"### `rule` Configuration Block\n" +
+		"\n" +
+		"The `rule` configuration block supports the following arguments:\n" +
+		"\n" +
+		"* `priority` - (Required) TODO: Describe this argument.\n"
+
+	if got := string(page.Render()); !strings.Contains(got, want) {
+		t.Errorf("expected arguments:\n%s\n\ngot:\n%s", want, got)
+	}
+}